@@ -0,0 +1,157 @@
+package codescout
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// CallEdge is a single static call from one function or method to another.
+type CallEdge struct {
+	// Qualified name of the calling function or method, e.g. "storage.Open" or "storage.Store.Get".
+	Caller string `json:"caller"`
+	// Qualified name of the called function or method, e.g. "strings.ToUpper".
+	Callee string `json:"callee"`
+	// Path to the file containing the call.
+	Path string `json:"path"`
+	// Line number of the call.
+	Line int `json:"line"`
+	// Column of the call.
+	Column int `json:"column"`
+
+	calleePkg string
+}
+
+// CallGraph is the static call graph of the scouted code. Functions are named by their
+// package path and function name, and methods by their package path, receiver type and
+// method name, so a call through an interface is recorded against the interface method.
+type CallGraph struct {
+	// Every resolved call, in the order the calls appear in the scouted files.
+	Edges []CallEdge `json:"edges"`
+}
+
+// Callers returns the calls made to the named function or method.
+func (g CallGraph) Callers(name string) []CallEdge {
+	return g.filter(func(edge CallEdge) bool { return symbolMatch(edge.Callee, name) })
+}
+
+// Callees returns the calls made by the named function or method.
+func (g CallGraph) Callees(name string) []CallEdge {
+	return g.filter(func(edge CallEdge) bool { return symbolMatch(edge.Caller, name) })
+}
+
+// filter returns the edges accepted by the keep function.
+func (g CallGraph) filter(keep func(CallEdge) bool) []CallEdge {
+	edges := make([]CallEdge, 0)
+	for _, edge := range g.Edges {
+		if keep(edge) {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// DOT renders the call graph in the Graphviz DOT language, with one edge per distinct
+// caller and callee pair.
+func (g CallGraph) DOT() string {
+	return callEdgesDOT(g.Edges)
+}
+
+// callEdgesDOT renders a list of edges as a DOT digraph with duplicate pairs removed.
+func callEdgesDOT(edges []CallEdge) string {
+	seen := make(map[[2]string]bool)
+	lines := make([]string, 0, len(edges))
+	for _, edge := range edges {
+		pair := [2]string{edge.Caller, edge.Callee}
+		if seen[pair] {
+			continue
+		}
+		seen[pair] = true
+		lines = append(lines, fmt.Sprintf("\t%q -> %q;", edge.Caller, edge.Callee))
+	}
+	sort.Strings(lines)
+
+	var builder strings.Builder
+	builder.WriteString("digraph calls {\n")
+	for _, line := range lines {
+		builder.WriteString(line + "\n")
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// symbolMatch returns true if the qualified symbol is the name, or ends with the name after
+// a dot or slash, so "Open", "storage.Open" and "example.com/storage.Open" all match
+// "example.com/storage.Open".
+func symbolMatch(symbol string, name string) bool {
+	return symbol == name || strings.HasSuffix(symbol, "."+name) || strings.HasSuffix(symbol, "/"+name)
+}
+
+// funcSymbol returns the name of a checked function or method qualified by its package path.
+func funcSymbol(fn *types.Func) string {
+	var qualifier string
+	if fn.Pkg() != nil {
+		qualifier = fn.Pkg().Path() + "."
+	}
+	if signature, ok := fn.Type().(*types.Signature); ok && signature.Recv() != nil {
+		return qualifier + receiverTypeSymbol(signature.Recv().Type()) + "." + fn.Name()
+	}
+	return qualifier + fn.Name()
+}
+
+// calledPackage returns the path of the package declaring a called function, or of the
+// package declaring the receiver type of a method, e.g. of an embedded interface.
+func calledPackage(fn *types.Func) string {
+	if fn.Pkg() == nil {
+		return ""
+	}
+	return fn.Pkg().Path()
+}
+
+// receiverTypeSymbol returns the name of a receiver type, without any pointer or type arguments.
+func receiverTypeSymbol(typ types.Type) string {
+	if pointer, ok := typ.(*types.Pointer); ok {
+		typ = pointer.Elem()
+	}
+	if named, ok := typ.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return types.TypeString(typ, func(*types.Package) string { return "" })
+}
+
+// declSymbol returns the name of a function or method declaration qualified by the package
+// it is declared in, e.g. "storage.Store.Get".
+func declSymbol(qualifier string, decl *ast.FuncDecl) string {
+	if decl.Recv != nil && len(decl.Recv.List) > 0 {
+		return qualifier + "." + receiverTypeName(decl.Recv.List[0].Type) + "." + decl.Name.Name
+	}
+	return qualifier + "." + decl.Name.Name
+}
+
+// calledFunc resolves the function or method called by a call expression, returning nil for
+// builtins, conversions and calls of function values.
+func calledFunc(info *types.Info, fun ast.Expr) *types.Func {
+	var fn *types.Func
+	switch expr := fun.(type) {
+	case *ast.ParenExpr:
+		return calledFunc(info, expr.X)
+	case *ast.IndexExpr:
+		return calledFunc(info, expr.X)
+	case *ast.IndexListExpr:
+		return calledFunc(info, expr.X)
+	case *ast.Ident:
+		fn, _ = info.Uses[expr].(*types.Func)
+	case *ast.SelectorExpr:
+		if selection, ok := info.Selections[expr]; ok {
+			fn, _ = selection.Obj().(*types.Func)
+		} else {
+			fn, _ = info.Uses[expr.Sel].(*types.Func)
+		}
+	}
+	if fn == nil {
+		return nil
+	}
+	return fn.Origin()
+}
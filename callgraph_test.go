@@ -0,0 +1,93 @@
+package codescout
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// callPairs returns the caller and callee of each edge.
+func callPairs(edges []CallEdge) [][2]string {
+	pairs := make([][2]string, 0, len(edges))
+	for _, edge := range edges {
+		pairs = append(pairs, [2]string{edge.Caller, edge.Callee})
+	}
+	return pairs
+}
+
+func TestScoutCallGraph(t *testing.T) {
+	path := filepath.Join("testdata", "scout_calls")
+	pkg := "github.com/galactixx/codescout/testdata/scout_calls"
+
+	graph, err := ScoutCallGraph(path, CallGraphConfig{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, [][2]string{
+		{pkg + ".Formal.Greet", "fmt.Sprintf"},
+		{pkg + ".Formal.Greet", pkg + ".Formal.normalize"},
+	}, callPairs(graph.Callees("Formal.Greet")))
+	assert.Equal(t, [][2]string{{pkg + ".Welcome", pkg + ".Greeter.Greet"}}, callPairs(graph.Callees("Welcome")))
+	assert.Equal(t, [][2]string{
+		{pkg + ".Run", pkg + ".Map"},
+		{pkg + ".Run", pkg + ".Formal.normalize"},
+		{pkg + ".Run", pkg + ".Welcome"},
+	}, callPairs(graph.Callees("scout_calls.Run")))
+	assert.Empty(t, graph.Callees("Map"))
+
+	callers := graph.Callers("strings.ToUpper")
+	assert.Len(t, callers, 1)
+	assert.Equal(t, pkg+".Formal.normalize", callers[0].Caller)
+	assert.Equal(t, filepath.Join(path, "calls.go"), callers[0].Path)
+	assert.Equal(t, 25, callers[0].Line)
+	assert.Equal(t, 9, callers[0].Column)
+
+	assert.Equal(t, [][2]string{
+		{pkg + ".Formal.Greet", pkg + ".Formal.normalize"},
+		{pkg + ".Run", pkg + ".Formal.normalize"},
+	}, callPairs(graph.Callers("normalize")))
+
+	local, err := ScoutCallGraph(path, CallGraphConfig{LocalOnly: true})
+	assert.NoError(t, err)
+	assert.Empty(t, local.Callers("ToUpper"))
+	assert.Len(t, local.Edges, 5)
+	assert.Equal(t, strings.ReplaceAll(`digraph calls {
+	"PKG.Formal.Greet" -> "PKG.Formal.normalize";
+	"PKG.Run" -> "PKG.Formal.normalize";
+	"PKG.Run" -> "PKG.Map";
+	"PKG.Run" -> "PKG.Welcome";
+	"PKG.Welcome" -> "PKG.Greeter.Greet";
+}
+`, "PKG", pkg), local.DOT())
+}
+
+func TestScoutCallGraphModuleRoot(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":            "module example.com/app\n\ngo 1.21\n",
+		"app.go":            "package app\n\nfunc Greet() string { return name() }\n\nfunc name() string { return \"app\" }\n",
+		"cmd/greet/main.go": "package main\n\nimport \"example.com/app\"\n\nfunc main() { println(app.Greet()) }\n",
+	}
+	for name, src := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+	}
+
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(root))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	graph, err := ScoutCallGraph("./...", CallGraphConfig{LocalOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, [][2]string{
+		{"example.com/app.Greet", "example.com/app.name"},
+		{"example.com/app/cmd/greet.main", "example.com/app.Greet"},
+	}, callPairs(graph.Edges))
+	assert.Equal(t, [][2]string{
+		{"example.com/app/cmd/greet.main", "example.com/app.Greet"},
+	}, callPairs(graph.Callers("example.com/app.Greet")))
+}
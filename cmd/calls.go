@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	callsCallers = flags.CommandFlag[string]{Name: "callers"}
+	callsCallees = flags.CommandFlag[string]{Name: "callees"}
+	callsFormat  = flags.CommandFlag[string]{Name: "format"}
+	callsLocal   = flags.CommandFlag[bool]{Name: "local"}
+	callsPartial = flags.CommandFlag[bool]{Name: "partial"}
+)
+
+var callsBatchValidator = flags.BatchValidator{
+	EmptyValidators: []flags.FlagValidator{&callsCallers, &callsCallees},
+}
+
+var callsCmd = &cobra.Command{
+	Use:   "calls <path>",
+	Short: "Print the static call graph of Go source",
+	Long: `Type-check the packages in a source file, directory, recursive ./... pattern or package and
+print every resolved call between functions and methods, as a DOT graph or JSON`,
+	Args: cobra.ExactArgs(1),
+	RunE: callsCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(callsCmd)
+
+	flags.StringVarP(callsCmd, &callsCallers, "", "", "only output the calls made to this function or method")
+	flags.StringVarP(callsCmd, &callsCallees, "", "", "only output the calls made by this function or method")
+	flags.StringVarP(callsCmd, &callsFormat, "", cmdutils.DOTFormat, cmdutils.GraphFormatUsage())
+	flags.BoolVarP(callsCmd, &callsLocal, "", false, "only output calls to functions declared in the scouted code (true/false)")
+	flags.BoolVarP(callsCmd, &callsPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+}
+
+func callsCmdRun(cmd *cobra.Command, args []string) error {
+	filePath := args[0]
+	validationErr := callsBatchValidator.Validate(cmd)
+	if validationErr != nil {
+		return validationErr
+	}
+	formatErr := cmdutils.GraphFormatValidation(callsFormat)
+	if formatErr != nil {
+		return formatErr
+	}
+
+	callGraphConfig := codescout.CallGraphConfig{
+		LocalOnly: callsLocal.Variable,
+		Workers:   workers,
+		Partial:   callsPartial.Variable,
+	}
+	graph, err := codescout.ScoutCallGraph(filePath, callGraphConfig)
+	if graph == nil {
+		return err
+	}
+
+	if callsCallers.Variable != "" {
+		graph.Edges = graph.Callers(callsCallers.Variable)
+	}
+	if callsCallees.Variable != "" {
+		graph.Edges = graph.Callees(callsCallees.Variable)
+	}
+	writeErr := cmdutils.WriteCallGraph(os.Stdout, callsFormat.Variable, *graph)
+	if writeErr != nil {
+		return writeErr
+	}
+	return err
+}
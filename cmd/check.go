@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/configfile"
+	"github.com/spf13/cobra"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check [rule...]",
+	Short: "Check Go source against the rules in the config file",
+	Long: `Check Go source against the rules in the config file, or only the named rules. Every node
+selected by a rule must match its assertion, and the nodes that do not are reported as
+violations, unless ignored in source with a //codescout:ignore <rule> comment`,
+	SilenceUsage: true,
+	RunE:         checkCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(checkCmd)
+}
+
+func checkCmdRun(cmd *cobra.Command, args []string) error {
+	file, err := loadConfigFile()
+	if err != nil {
+		return err
+	}
+	names := args
+	if len(names) == 0 {
+		names = file.RuleNames()
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no rules were found in %s", file.Path)
+	}
+
+	suppressions := cmdutils.NewSuppressions()
+	violations := 0
+	for _, name := range names {
+		rule, ruleErr := file.Rule(name)
+		if ruleErr != nil {
+			return ruleErr
+		}
+		found, checkErr := checkRule(name, rule, file.ResolvePaths(rule.Paths))
+		if checkErr != nil {
+			return fmt.Errorf("rule %s: %w", name, checkErr)
+		}
+
+		for _, violation := range found {
+			suppressed, suppressedErr := suppressions.Suppressed(name, violation.Node)
+			if suppressedErr != nil {
+				return suppressedErr
+			}
+			if !suppressed {
+				fmt.Println(violation)
+				violations++
+			}
+		}
+	}
+
+	if violations > 0 {
+		return fmt.Errorf("%d violations were found", violations)
+	}
+	return nil
+}
+
+// checkRule returns the violations of a rule in the paths.
+func checkRule(name string, rule configfile.Rule, paths []string) ([]cmdutils.Violation, error) {
+	switch rule.Kind {
+	case "func":
+		return checkNodes(name, rule, paths, funcConfigFromValues, codescout.ScoutFunctions,
+			func(node *codescout.FuncNode) codescout.BaseNode { return node.Node })
+	case "method":
+		return checkNodes(name, rule, paths, methodConfigFromValues, codescout.ScoutMethods,
+			func(node *codescout.MethodNode) codescout.BaseNode { return node.Node })
+	default:
+		return checkNodes(name, rule, paths, structConfigFromValues, codescout.ScoutStructs,
+			func(node *codescout.StructNode) codescout.BaseNode { return node.Node })
+	}
+}
+
+// checkNodes scouts the nodes selected by a rule and the nodes matching its assertion, and
+// returns a violation for each selected node that does not match the assertion.
+func checkNodes[T any, C any](
+	name string,
+	rule configfile.Rule,
+	paths []string,
+	configFromValues func(values cmdutils.ConfigValues) (C, error),
+	scoutAll func(path string, config C) ([]*T, error),
+	baseNode func(node *T) codescout.BaseNode,
+) ([]cmdutils.Violation, error) {
+	selectConfig, err := configFromValues(rule.SelectFlags())
+	if err != nil {
+		return nil, err
+	}
+	assertConfig, err := configFromValues(rule.AssertFlags())
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]cmdutils.Violation, 0)
+	for _, path := range paths {
+		selected, selectErr := scoutAll(path, selectConfig)
+		if selectErr != nil {
+			return nil, selectErr
+		}
+		asserted, assertErr := scoutAll(path, assertConfig)
+		if assertErr != nil {
+			return nil, assertErr
+		}
+
+		matched := make(map[nodeKey]bool, len(asserted))
+		for _, node := range asserted {
+			matched[newNodeKey(baseNode(node))] = true
+		}
+		for _, node := range selected {
+			base := baseNode(node)
+			if !matched[newNodeKey(base)] {
+				violations = append(violations, cmdutils.Violation{
+					Rule: name, Description: rule.Description, Kind: rule.Kind, Node: base,
+				})
+			}
+		}
+	}
+	return violations, nil
+}
+
+// nodeKey identifies a node by the file and offset it is declared at.
+type nodeKey struct {
+	path   string
+	offset int
+}
+
+func newNodeKey(node codescout.BaseNode) nodeKey {
+	return nodeKey{path: node.Path, offset: node.Range.Start.Offset}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/configfile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const checkSource = `package repo
+
+import "context"
+
+type Repo struct{}
+
+func (r *Repo) Get(ctx context.Context, id int) error { return nil }
+
+func (r *Repo) Count(id int, ctx context.Context) int { return 0 }
+
+func (r *Repo) List() error { return nil }
+
+func (r *Repo) reset(id int) {}
+`
+
+// violationNames returns the names of the nodes violating a rule.
+func violationNames(violations []cmdutils.Violation) []string {
+	names := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		names = append(names, violation.Node.Name)
+	}
+	return names
+}
+
+func TestCheckRule(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo.go")
+	require.NoError(t, os.WriteFile(path, []byte(checkSource), 0o644))
+
+	rule := configfile.Rule{
+		Kind:   "method",
+		Select: map[string]any{"receiver": "Repo", "name": "[A-Z]*", "match": "glob"},
+		Assert: map[string]any{"params": []any{"ctx:context.Context"}, "ordered-params": true},
+	}
+	violations, err := checkRule("repo-ctx", rule, []string{path})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Count", "List"}, violationNames(violations))
+
+	delete(rule.Assert, "ordered-params")
+	violations, err = checkRule("repo-ctx", rule, []string{path})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"List"}, violationNames(violations))
+
+	_, err = checkRule("repo-ctx", configfile.Rule{Kind: "func", Assert: map[string]any{"receiver": "Repo"}}, []string{path})
+	assert.EqualError(t, err, "flag receiver cannot be used in a func rule")
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	constName       = flags.CommandFlag[string]{Name: "name"}
+	constType       = flags.CommandFlag[string]{Name: "type"}
+	constOutputType = flags.CommandFlag[string]{Name: "output"}
+	constExported   = flags.CommandFlag[string]{Name: "exported"}
+	constHasValue   = flags.CommandFlag[string]{Name: "has-value"}
+	constIsEnum     = flags.CommandFlag[string]{Name: "enum"}
+	constVerbose    = flags.CommandFlag[bool]{Name: "verbose"}
+	constPartial    = flags.CommandFlag[bool]{Name: "partial"}
+	constSort       = flags.CommandFlag[string]{Name: "sort"}
+	constMatch      = flags.CommandFlag[string]{Name: "match"}
+)
+
+var constOptions = cmdutils.OutputOptions[*codescout.ConstNode]{Options: map[string]func(*codescout.ConstNode) string{
+	"definition": func(node *codescout.ConstNode) string { return node.Code() },
+	"comment":    func(node *codescout.ConstNode) string { return node.Comments() },
+	"type":       func(node *codescout.ConstNode) string { return node.Type() },
+	"values":     func(node *codescout.ConstNode) string { return constValues(node) },
+}}
+
+var constBatchValidator = flags.BatchValidator{
+	EmptyValidators:      []flags.FlagValidator{&constName, &constType},
+	StringBoolValidators: []*flags.CommandFlag[string]{&constExported, &constHasValue, &constIsEnum},
+}
+
+var constCommandValidation = cmdutils.CobraCommandVlidation[*codescout.ConstNode]{
+	Validator:      constBatchValidator,
+	OutputTypeFlag: &constOutputType,
+	MatchModeFlag:  &constMatch,
+	SortFlag:       &constSort,
+	OutputOptions:  constOptions,
+}
+
+var constCmd = &cobra.Command{
+	Use:   "const",
+	Short: "Find a single constant or enum in Go source",
+	Long:  "Locate and display a package-level constant or iota enum group within a source file, directory, recursive ./... pattern or package",
+	Args:  cobra.ExactArgs(1),
+	RunE:  constCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(constCmd)
+
+	flags.StringVarP(constCmd, &constName, "n", "", "the constant, enum type or enum member name")
+	flags.StringVarP(constCmd, &constType, "t", "", "declared type of the constant")
+	flags.StringVarP(constCmd, &constExported, "e", "", "if the constant is exported (true/false)")
+	flags.StringVarP(constCmd, &constHasValue, "a", "", "if every value is written explicitly (true/false)")
+	flags.StringVarP(constCmd, &constIsEnum, "i", "", "if the constant is an iota enum group (true/false)")
+	flags.BoolVarP(constCmd, &constVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
+	flags.BoolVarP(constCmd, &constPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(constCmd, &constSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(constCmd, &constMatch, "", cmdutils.ExactMatch, cmdutils.MatchModeUsage())
+	flags.StringVarP(
+		constCmd,
+		&constOutputType,
+		"o",
+		"definition",
+		fmt.Sprintf("part of constant to output, must be one of: %s", constOptions.ToOptionString()),
+	)
+}
+
+func constValues(node *codescout.ConstNode) string {
+	values := make([]string, 0, len(node.Members))
+	for _, member := range node.Members {
+		values = append(values, fmt.Sprintf("%s = %s", member.Name, member.Evaluated))
+	}
+	return strings.Join(values, "\n")
+}
+
+func constCmdRun(cmd *cobra.Command, args []string) error {
+	filePath := args[0]
+	validationErr := constCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return validationErr
+	}
+
+	name, namePattern, patternMode := cmdutils.NamePattern(constMatch.Variable, constName.Variable)
+	constConfig := codescout.ConstConfig{
+		Name:        name,
+		NamePattern: namePattern,
+		PatternMode: patternMode,
+		Type:        constType.Variable,
+		Exported:    flags.StringBoolToPointer(constExported.Variable),
+		HasValue:    flags.StringBoolToPointer(constHasValue.Variable),
+		IsEnum:      flags.StringBoolToPointer(constIsEnum.Variable),
+		Sort:        codescout.SortOrder(constSort.Variable),
+		Workers:     workers,
+		Partial:     constPartial.Variable,
+	}
+	scoutContainer := cmdutils.NewScoutContainer(
+		codescout.ScoutConst,
+		codescout.ScoutConsts,
+		filePath,
+		constOptions,
+		constConfig,
+		"Constant",
+		constOutputType.Variable,
+	)
+	return scoutContainer.Display(constVerbose.Variable)
+}
@@ -18,14 +18,27 @@ var (
 	funcNoReturn       = flags.CommandFlag[string]{Name: "no-return"}
 	funcVerbose        = flags.CommandFlag[bool]{Name: "verbose"}
 	funcExact          = flags.CommandFlag[bool]{Name: "exact"}
+	funcOrderedParams  = flags.CommandFlag[bool]{Name: "ordered-params"}
+	funcPartial        = flags.CommandFlag[bool]{Name: "partial"}
+	funcIndex          = flags.CommandFlag[string]{Name: "index"}
+	funcSort           = flags.CommandFlag[string]{Name: "sort"}
+	funcMatch          = flags.CommandFlag[string]{Name: "match"}
+	funcFormat         = flags.CommandFlag[string]{Name: "format"}
+	funcTypeMatch      = flags.CommandFlag[string]{Name: "type-match"}
+	funcTypeParams     = flags.CommandFlag[[]string]{Name: "type-params"}
+	funcNoTypeParams   = flags.CommandFlag[string]{Name: "no-type-params"}
+	funcGeneric        = flags.CommandFlag[string]{Name: "generic"}
+	funcExclParams     = flags.CommandFlag[[]string]{Name: "exclude-params"}
+	funcExclReturn     = flags.CommandFlag[[]string]{Name: "exclude-return"}
 )
 
 var funcOptions = cmdutils.OutputOptions[*codescout.FuncNode]{Options: map[string]func(*codescout.FuncNode) string{
-	"definition": func(node *codescout.FuncNode) string { return node.CallableOps.Code() },
-	"body":       func(node *codescout.FuncNode) string { return node.CallableOps.Body() },
-	"signature":  func(node *codescout.FuncNode) string { return node.CallableOps.Signature() },
-	"comment":    func(node *codescout.FuncNode) string { return node.CallableOps.Comments() },
-	"return":     func(node *codescout.FuncNode) string { return node.CallableOps.ReturnType() },
+	"definition":  func(node *codescout.FuncNode) string { return node.CallableOps.Code() },
+	"body":        func(node *codescout.FuncNode) string { return node.CallableOps.Body() },
+	"signature":   func(node *codescout.FuncNode) string { return node.CallableOps.Signature() },
+	"comment":     func(node *codescout.FuncNode) string { return node.CallableOps.Comments() },
+	"type-params": func(node *codescout.FuncNode) string { return cmdutils.TypeParamsOutput(node.CallableOps.TypeParams()) },
+	"return":      func(node *codescout.FuncNode) string { return node.CallableOps.ReturnType() },
 }}
 
 var funcBatchValidator = flags.BatchValidator{
@@ -33,21 +46,28 @@ var funcBatchValidator = flags.BatchValidator{
 		&funcName,
 		&funcParameterTypes,
 		&funcReturnTypes,
+		&funcTypeParams,
+		&funcExclParams,
+		&funcExclReturn,
 	},
-	StringBoolValidators: []*flags.CommandFlag[string]{&funcNoParams, &funcNoReturn},
+	StringBoolValidators: []*flags.CommandFlag[string]{&funcNoParams, &funcNoReturn, &funcNoTypeParams, &funcGeneric},
 }
 
 var funcCommandValidation = cmdutils.CobraCommandVlidation[*codescout.FuncNode]{
 	Validator:      funcBatchValidator,
 	NamedTypesFlag: &funcParameterTypes,
 	OutputTypeFlag: &funcOutputType,
+	MatchModeFlag:  &funcMatch,
+	FormatFlag:     &funcFormat,
+	TypeMatchFlag:  &funcTypeMatch,
+	SortFlag:       &funcSort,
 	OutputOptions:  funcOptions,
 }
 
 var funcCmd = &cobra.Command{
 	Use:   "func",
-	Short: "Find a single function in a file",
-	Long:  "Locate and display a specific function definition within a given source file",
+	Short: "Find a single function in Go source",
+	Long:  "Locate and display a specific function definition within a source file, directory, recursive ./... pattern or package",
 	Args:  cobra.ExactArgs(1),
 	RunE:  funcCmdRun,
 }
@@ -58,10 +78,24 @@ func init() {
 	flags.StringVarP(funcCmd, &funcName, "n", "", "the function name")
 	flags.StringSliceVarP(funcCmd, &funcParameterTypes, "p", make([]string, 0), "parameter names and types of function")
 	flags.StringSliceVarP(funcCmd, &funcReturnTypes, "r", make([]string, 0), "return types of function")
+	flags.StringSliceVarP(funcCmd, &funcExclParams, "", make([]string, 0), "parameter names and types the function must not have")
+	flags.StringSliceVarP(funcCmd, &funcExclReturn, "", make([]string, 0), "return types the function must not have")
 	flags.StringVarP(funcCmd, &funcNoParams, "s", "", "if the function has no parameters (true/false)")
 	flags.StringVarP(funcCmd, &funcNoReturn, "u", "", "if the function has no return type (true/false)")
+	flags.StringSliceVarP(funcCmd, &funcTypeParams, "", make([]string, 0), "type parameter names and constraints of function")
+	flags.StringVarP(funcCmd, &funcNoTypeParams, "", "", "if the function has no type parameters (true/false)")
+	flags.StringVarP(funcCmd, &funcGeneric, "", "", "if the function is generic (true/false)")
 	flags.BoolVarP(funcCmd, &funcVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
 	flags.BoolVarP(funcCmd, &funcExact, "x", false, "if an exact match should occur with slice flags (true/false)")
+	flags.BoolVarP(funcCmd, &funcOrderedParams, "", false, "if the params must be the leading parameters in order, all of them with --exact (true/false)")
+	flags.BoolVarP(funcCmd, &funcPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(funcCmd, &funcIndex, "", "", "answer from the index file at this path, updating it first (see index build)")
+	flags.StringVarP(funcCmd, &funcSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(funcCmd, &funcMatch, "", cmdutils.ExactMatch, cmdutils.MatchModeUsage())
+	flags.StringVarP(funcCmd, &funcFormat, "", cmdutils.TextFormat, cmdutils.FormatUsage())
+	flags.StringVarP(
+		funcCmd, &funcTypeMatch, "", string(codescout.TextualTypes), cmdutils.TypeMatchUsage(),
+	)
 	flags.StringVarP(
 		funcCmd,
 		&funcOutputType,
@@ -73,27 +107,78 @@ func init() {
 
 func funcCmdRun(cmd *cobra.Command, args []string) error {
 	filePath := args[0]
-	validationErr := funcCommandValidation.CommandValidation(cmd)
-	if validationErr != nil {
-		return validationErr
+	functionConfig, configErr := funcConfigFromFlags(cmd)
+	if configErr != nil {
+		return configErr
 	}
-
-	functionConfig := codescout.FuncConfig{
-		Name:        funcName.Variable,
-		ParamTypes:  funcCommandValidation.GetNamedTypes(),
-		ReturnTypes: funcReturnTypes.Variable,
-		NoParams:    flags.StringBoolToPointer(funcNoParams.Variable),
-		NoReturn:    flags.StringBoolToPointer(funcNoReturn.Variable),
-		Exact:       funcExact.Variable,
+	scoutFirst, scoutAll := codescout.ScoutFunction, codescout.ScoutFunctions
+	if funcIndex.Variable != "" {
+		index, _, indexErr := cmdutils.OpenIndex(funcIndex.Variable, filePath)
+		if indexErr != nil {
+			return indexErr
+		}
+		scoutFirst, scoutAll = cmdutils.IndexScouts(index.ScoutFunction, index.ScoutFunctions)
 	}
 	scoutContainer := cmdutils.NewScoutContainer(
-		codescout.ScoutFunction,
-		codescout.ScoutFunctions,
+		scoutFirst,
+		scoutAll,
 		filePath,
 		funcOptions,
 		functionConfig,
 		"Function",
 		funcOutputType.Variable,
 	)
-	return scoutContainer.Display(funcVerbose.Variable)
+	return scoutContainer.WithFormat(funcFormat.Variable).Display(funcVerbose.Variable)
+}
+
+// funcConfigFromFlags validates the flags of the func command and returns the config they describe.
+func funcConfigFromFlags(cmd *cobra.Command) (codescout.FuncConfig, error) {
+	validationErr := funcCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return codescout.FuncConfig{}, validationErr
+	}
+	return funcConfigFromValues(cmdutils.NewFlagValues(cmd))
+}
+
+// funcConfigFromValues returns the config described by the values of the func command's
+// flags, read from the command or from a func rule of the config file.
+func funcConfigFromValues(values cmdutils.ConfigValues) (codescout.FuncConfig, error) {
+	params, paramsErr := cmdutils.ArgsToNamedTypes(values.Strings(funcParameterTypes.Name))
+	if paramsErr != nil {
+		return codescout.FuncConfig{}, paramsErr
+	}
+
+	typeParams, typeParamsErr := cmdutils.ArgsToNamedTypes(values.Strings(funcTypeParams.Name))
+	if typeParamsErr != nil {
+		return codescout.FuncConfig{}, typeParamsErr
+	}
+
+	excludedParams, excludedParamsErr := cmdutils.ArgsToNamedTypes(values.Strings(funcExclParams.Name))
+	if excludedParamsErr != nil {
+		return codescout.FuncConfig{}, excludedParamsErr
+	}
+
+	match := values.OneOf(funcMatch.Name, cmdutils.MatchModes, cmdutils.ExactMatch)
+	name, namePattern, patternMode := cmdutils.NamePattern(match, values.String(funcName.Name))
+	functionConfig := codescout.FuncConfig{
+		Name:               name,
+		NamePattern:        namePattern,
+		PatternMode:        patternMode,
+		ParamTypes:         params,
+		ReturnTypes:        values.Strings(funcReturnTypes.Name),
+		ExcludeParamTypes:  excludedParams,
+		ExcludeReturnTypes: values.Strings(funcExclReturn.Name),
+		NoParams:           values.OptionalBool(funcNoParams.Name),
+		NoReturn:           values.OptionalBool(funcNoReturn.Name),
+		TypeParams:         typeParams,
+		NoTypeParams:       values.OptionalBool(funcNoTypeParams.Name),
+		IsGeneric:          values.OptionalBool(funcGeneric.Name),
+		Exact:              values.Bool(funcExact.Name),
+		OrderedParams:      values.Bool(funcOrderedParams.Name),
+		TypeMatch:          codescout.TypeMatchMode(values.OneOf(funcTypeMatch.Name, cmdutils.TypeMatchModes, string(codescout.TextualTypes))),
+		Sort:               codescout.SortOrder(values.OneOf(funcSort.Name, cmdutils.SortOrders, string(codescout.PositionSort))),
+		Workers:            workers,
+		Partial:            values.Bool(funcPartial.Name),
+	}
+	return functionConfig, values.Err()
 }
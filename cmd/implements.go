@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	implementsOutputType = flags.CommandFlag[string]{Name: "output"}
+	implementsVerbose    = flags.CommandFlag[bool]{Name: "verbose"}
+	implementsPartial    = flags.CommandFlag[bool]{Name: "partial"}
+	implementsSort       = flags.CommandFlag[string]{Name: "sort"}
+)
+
+var implementsOptions = cmdutils.OutputOptions[*codescout.ImplementsNode]{Options: map[string]func(*codescout.ImplementsNode) string{
+	"definition": func(node *codescout.ImplementsNode) string { return node.Code() },
+	"receiver":   func(node *codescout.ImplementsNode) string { return node.Receiver() },
+	"comment":    func(node *codescout.ImplementsNode) string { return node.Comments() },
+	"methods":    func(node *codescout.ImplementsNode) string { return cmdutils.JoinAttrs(implementsMethodNames(node)) },
+}}
+
+var implementsCommandValidation = cmdutils.CobraCommandVlidation[*codescout.ImplementsNode]{
+	OutputTypeFlag: &implementsOutputType,
+	SortFlag:       &implementsSort,
+	OutputOptions:  implementsOptions,
+}
+
+var implementsCmd = &cobra.Command{
+	Use:   "implements <interface> <path>",
+	Short: "Find the types that implement an interface",
+	Long: `Locate and display every named type whose method set satisfies an interface, either one
+declared in the scouted code or a package-qualified one such as io.Reader`,
+	Args: cobra.ExactArgs(2),
+	RunE: implementsCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(implementsCmd)
+
+	flags.BoolVarP(implementsCmd, &implementsVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
+	flags.BoolVarP(implementsCmd, &implementsPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(implementsCmd, &implementsSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(
+		implementsCmd,
+		&implementsOutputType,
+		"o",
+		"receiver",
+		fmt.Sprintf("part of type to output, must be one of: %s", implementsOptions.ToOptionString()),
+	)
+}
+
+func implementsMethodNames(node *codescout.ImplementsNode) []string {
+	names := make([]string, 0, len(node.Methods))
+	for _, method := range node.Methods {
+		names = append(names, method.Name())
+	}
+	return names
+}
+
+func implementsCmdRun(cmd *cobra.Command, args []string) error {
+	filePath := args[1]
+	validationErr := implementsCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return validationErr
+	}
+
+	implementsConfig := codescout.ImplementsConfig{
+		Interface: args[0],
+		Sort:      codescout.SortOrder(implementsSort.Variable),
+		Workers:   workers,
+		Partial:   implementsPartial.Variable,
+	}
+	scoutContainer := cmdutils.NewScoutContainer(
+		codescout.ScoutImplementation,
+		codescout.ScoutImplementations,
+		filePath,
+		implementsOptions,
+		implementsConfig,
+		"Type",
+		implementsOutputType.Variable,
+	)
+	return scoutContainer.Display(implementsVerbose.Variable)
+}
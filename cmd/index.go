@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var indexPath = flags.CommandFlag[string]{Name: "index"}
+
+var indexCmd = &cobra.Command{
+	Use:   "index",
+	Short: "Manage the local index of Go source",
+	Long: `Manage a local index of the functions, methods and structs in Go source, which the func,
+method and struct commands answer from when given --index`,
+}
+
+var indexBuildCmd = &cobra.Command{
+	Use:   "build <path>",
+	Short: "Build or update the index of Go source",
+	Long: `Extract the functions, methods and structs in a source file, directory, recursive ./... pattern
+or package into an index file, only scouting again the packages whose files changed since the
+index was last built`,
+	Args: cobra.ExactArgs(1),
+	RunE: indexBuildCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(indexCmd)
+	indexCmd.AddCommand(indexBuildCmd)
+
+	flags.StringVarP(indexBuildCmd, &indexPath, "", cmdutils.DefaultIndexPath, "path of the index file")
+}
+
+func indexBuildCmdRun(cmd *cobra.Command, args []string) error {
+	filePath := args[0]
+	index, updated, err := cmdutils.OpenIndex(indexPath.Variable, filePath)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("indexed %d files, %d updated, in %s\n", len(index.Files), updated, indexPath.Variable)
+	return nil
+}
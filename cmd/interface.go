@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	interfaceName       = flags.CommandFlag[string]{Name: "name"}
+	interfaceOutputType = flags.CommandFlag[string]{Name: "output"}
+	interfaceMethods    = flags.CommandFlag[[]string]{Name: "methods"}
+	interfaceEmbeds     = flags.CommandFlag[[]string]{Name: "embeds"}
+	interfaceTypeSet    = flags.CommandFlag[[]string]{Name: "type-set"}
+	interfaceNoMethods  = flags.CommandFlag[string]{Name: "no-methods"}
+	interfaceNoEmbeds   = flags.CommandFlag[string]{Name: "no-embeds"}
+	interfaceVerbose    = flags.CommandFlag[bool]{Name: "verbose"}
+	interfaceExact      = flags.CommandFlag[bool]{Name: "exact"}
+	interfacePartial    = flags.CommandFlag[bool]{Name: "partial"}
+	interfaceSort       = flags.CommandFlag[string]{Name: "sort"}
+	interfaceMatch      = flags.CommandFlag[string]{Name: "match"}
+)
+
+var interfaceOptions = cmdutils.OutputOptions[*codescout.InterfaceNode]{Options: map[string]func(*codescout.InterfaceNode) string{
+	"definition": func(node *codescout.InterfaceNode) string { return node.Code() },
+	"body":       func(node *codescout.InterfaceNode) string { return node.Body() },
+	"signature":  func(node *codescout.InterfaceNode) string { return node.Signature() },
+	"comment":    func(node *codescout.InterfaceNode) string { return node.Comments() },
+	"methods":    func(node *codescout.InterfaceNode) string { return cmdutils.JoinAttrs(methodSignatureNames(node)) },
+	"embeds":     func(node *codescout.InterfaceNode) string { return cmdutils.JoinAttrs(node.Embeds()) },
+}}
+
+var interfaceBatchValidator = flags.BatchValidator{
+	EmptyValidators: []flags.FlagValidator{
+		&interfaceName,
+		&interfaceMethods,
+		&interfaceEmbeds,
+		&interfaceTypeSet,
+	},
+	StringBoolValidators: []*flags.CommandFlag[string]{&interfaceNoMethods, &interfaceNoEmbeds},
+}
+
+var interfaceCommandValidation = cmdutils.CobraCommandVlidation[*codescout.InterfaceNode]{
+	Validator:      interfaceBatchValidator,
+	OutputTypeFlag: &interfaceOutputType,
+	MatchModeFlag:  &interfaceMatch,
+	SortFlag:       &interfaceSort,
+	OutputOptions:  interfaceOptions,
+}
+
+var interfaceCmd = &cobra.Command{
+	Use:   "interface",
+	Short: "Find a single interface in Go source",
+	Long:  "Locate and display a specific interface definition within a source file, directory, recursive ./... pattern or package",
+	Args:  cobra.ExactArgs(1),
+	RunE:  interfaceCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(interfaceCmd)
+
+	flags.StringVarP(interfaceCmd, &interfaceName, "n", "", "the interface name")
+	flags.StringSliceVarP(interfaceCmd, &interfaceMethods, "m", make([]string, 0), "names of methods declared by interface")
+	flags.StringSliceVarP(interfaceCmd, &interfaceEmbeds, "e", make([]string, 0), "interfaces embedded in interface")
+	flags.StringSliceVarP(interfaceCmd, &interfaceTypeSet, "t", make([]string, 0), "type-set constraint terms of interface")
+	flags.StringVarP(interfaceCmd, &interfaceNoMethods, "s", "", "if the interface declares no methods (true/false)")
+	flags.StringVarP(interfaceCmd, &interfaceNoEmbeds, "d", "", "if the interface embeds no interfaces (true/false)")
+	flags.BoolVarP(interfaceCmd, &interfaceVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
+	flags.BoolVarP(interfaceCmd, &interfaceExact, "x", false, "if an exact match should occur with slice flags (true/false)")
+	flags.BoolVarP(interfaceCmd, &interfacePartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(interfaceCmd, &interfaceSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(interfaceCmd, &interfaceMatch, "", cmdutils.ExactMatch, cmdutils.MatchModeUsage())
+	flags.StringVarP(
+		interfaceCmd,
+		&interfaceOutputType,
+		"o",
+		"definition",
+		fmt.Sprintf("part of interface to output, must be one of: %s", interfaceOptions.ToOptionString()),
+	)
+}
+
+func methodSignatureNames(node *codescout.InterfaceNode) []string {
+	names := make([]string, 0, 5)
+	for _, method := range node.Methods() {
+		names = append(names, method.Name)
+	}
+	return names
+}
+
+func interfaceCmdRun(cmd *cobra.Command, args []string) error {
+	filePath := args[0]
+	validationErr := interfaceCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return validationErr
+	}
+
+	methods := make([]codescout.MethodSignature, 0, len(interfaceMethods.Variable))
+	for _, method := range interfaceMethods.Variable {
+		methods = append(methods, codescout.MethodSignature{Name: method})
+	}
+
+	name, namePattern, patternMode := cmdutils.NamePattern(interfaceMatch.Variable, interfaceName.Variable)
+	interfaceConfig := codescout.InterfaceConfig{
+		Name:        name,
+		NamePattern: namePattern,
+		PatternMode: patternMode,
+		Methods:     methods,
+		Embeds:      interfaceEmbeds.Variable,
+		TypeSet:     interfaceTypeSet.Variable,
+		NoMethods:   flags.StringBoolToPointer(interfaceNoMethods.Variable),
+		NoEmbeds:    flags.StringBoolToPointer(interfaceNoEmbeds.Variable),
+		Exact:       interfaceExact.Variable,
+		Sort:        codescout.SortOrder(interfaceSort.Variable),
+		Workers:     workers,
+		Partial:     interfacePartial.Variable,
+	}
+	scoutContainer := cmdutils.NewScoutContainer(
+		codescout.ScoutInterface,
+		codescout.ScoutInterfaces,
+		filePath,
+		interfaceOptions,
+		interfaceConfig,
+		"Interface",
+		interfaceOutputType.Variable,
+	)
+	return scoutContainer.Display(interfaceVerbose.Variable)
+}
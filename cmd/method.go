@@ -24,6 +24,20 @@ var (
 	noMethodsCalled      = flags.CommandFlag[string]{Name: "no-methods"}
 	methodVerbose        = flags.CommandFlag[bool]{Name: "verbose"}
 	methodExact          = flags.CommandFlag[bool]{Name: "exact"}
+	methodOrderedParams  = flags.CommandFlag[bool]{Name: "ordered-params"}
+	methodPartial        = flags.CommandFlag[bool]{Name: "partial"}
+	methodIndex          = flags.CommandFlag[string]{Name: "index"}
+	methodSort           = flags.CommandFlag[string]{Name: "sort"}
+	methodMatch          = flags.CommandFlag[string]{Name: "match"}
+	methodFormat         = flags.CommandFlag[string]{Name: "format"}
+	methodTypeMatch      = flags.CommandFlag[string]{Name: "type-match"}
+	methodTypeParams     = flags.CommandFlag[[]string]{Name: "type-params"}
+	methodNoTypeParams   = flags.CommandFlag[string]{Name: "no-type-params"}
+	methodGeneric        = flags.CommandFlag[string]{Name: "generic"}
+	methodExclParams     = flags.CommandFlag[[]string]{Name: "exclude-params"}
+	methodExclReturn     = flags.CommandFlag[[]string]{Name: "exclude-return"}
+	exclFieldsAccessed   = flags.CommandFlag[[]string]{Name: "exclude-fields"}
+	exclMethodsCalled    = flags.CommandFlag[[]string]{Name: "exclude-methods"}
 )
 
 var methodOptions = cmdutils.OutputOptions[*codescout.MethodNode]{Options: map[string]func(*codescout.MethodNode) string{
@@ -33,6 +47,7 @@ var methodOptions = cmdutils.OutputOptions[*codescout.MethodNode]{Options: map[s
 	"comment":          func(node *codescout.MethodNode) string { return node.CallableOps.Comments() },
 	"return":           func(node *codescout.MethodNode) string { return node.CallableOps.ReturnType() },
 	"receiver":         func(node *codescout.MethodNode) string { return node.ReceiverType() },
+	"type-params":      func(node *codescout.MethodNode) string { return cmdutils.TypeParamsOutput(node.TypeParams()) },
 	"receiver-fields":  func(node *codescout.MethodNode) string { return cmdutils.JoinAttrs(node.FieldsAccessed()) },
 	"receiver-methods": func(node *codescout.MethodNode) string { return cmdutils.JoinAttrs(node.MethodsCalled()) },
 }}
@@ -45,6 +60,11 @@ var methodBatchValidator = flags.BatchValidator{
 		&methodReturnTypes,
 		&fieldsAccessed,
 		&methodsCalled,
+		&methodTypeParams,
+		&methodExclParams,
+		&methodExclReturn,
+		&exclFieldsAccessed,
+		&exclMethodsCalled,
 	},
 	StringBoolValidators: []*flags.CommandFlag[string]{
 		&methodNoParams,
@@ -52,6 +72,8 @@ var methodBatchValidator = flags.BatchValidator{
 		&noFieldsAccessed,
 		&noMethodsCalled,
 		&hasPointerReceiver,
+		&methodNoTypeParams,
+		&methodGeneric,
 	},
 }
 
@@ -59,13 +81,17 @@ var methodCommandValidation = cmdutils.CobraCommandVlidation[*codescout.MethodNo
 	Validator:      methodBatchValidator,
 	NamedTypesFlag: &methodParameterTypes,
 	OutputTypeFlag: &methodOutputType,
+	MatchModeFlag:  &methodMatch,
+	FormatFlag:     &methodFormat,
+	TypeMatchFlag:  &methodTypeMatch,
+	SortFlag:       &methodSort,
 	OutputOptions:  methodOptions,
 }
 
 var methodCmd = &cobra.Command{
 	Use:   "method",
-	Short: "Find a single method in a file",
-	Long:  "Locate and display a specific method definition within a given source file",
+	Short: "Find a single method in Go source",
+	Long:  "Locate and display a specific method definition within a source file, directory, recursive ./... pattern or package",
 	Args:  cobra.ExactArgs(1),
 	RunE:  methodCmdRun,
 }
@@ -80,12 +106,28 @@ func init() {
 	flags.StringVarP(methodCmd, &hasPointerReceiver, "t", "", "whether method has a pointer receiver (true/false)")
 	flags.StringSliceVarP(methodCmd, &fieldsAccessed, "f", make([]string, 0), "struct fields accessed")
 	flags.StringSliceVarP(methodCmd, &methodsCalled, "c", make([]string, 0), "struct methods called")
+	flags.StringSliceVarP(methodCmd, &methodExclParams, "", make([]string, 0), "parameter names and types the method must not have")
+	flags.StringSliceVarP(methodCmd, &methodExclReturn, "", make([]string, 0), "return types the method must not have")
+	flags.StringSliceVarP(methodCmd, &exclFieldsAccessed, "", make([]string, 0), "struct fields that must not be accessed")
+	flags.StringSliceVarP(methodCmd, &exclMethodsCalled, "", make([]string, 0), "struct methods that must not be called")
 	flags.StringVarP(methodCmd, &methodNoParams, "s", "", "if the method has no parameters (true/false)")
 	flags.StringVarP(methodCmd, &methodNoReturn, "u", "", "if the method has no return type (true/false)")
 	flags.StringVarP(methodCmd, &noFieldsAccessed, "d", "", "if the method does not access struct fields (true/false)")
 	flags.StringVarP(methodCmd, &noMethodsCalled, "e", "", "if the method does not call struct methods (true/false)")
+	flags.StringSliceVarP(methodCmd, &methodTypeParams, "", make([]string, 0), "type parameter names and constraints of the method's receiver")
+	flags.StringVarP(methodCmd, &methodNoTypeParams, "", "", "if the method's receiver has no type parameters (true/false)")
+	flags.StringVarP(methodCmd, &methodGeneric, "", "", "if the method has a generic receiver type (true/false)")
 	flags.BoolVarP(methodCmd, &methodVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
 	flags.BoolVarP(methodCmd, &methodExact, "x", false, "if an exact match should occur with slice flags (true/false)")
+	flags.BoolVarP(methodCmd, &methodOrderedParams, "", false, "if the params must be the leading parameters in order, all of them with --exact (true/false)")
+	flags.BoolVarP(methodCmd, &methodPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(methodCmd, &methodIndex, "", "", "answer from the index file at this path, updating it first (see index build)")
+	flags.StringVarP(methodCmd, &methodSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(methodCmd, &methodMatch, "", cmdutils.ExactMatch, cmdutils.MatchModeUsage())
+	flags.StringVarP(methodCmd, &methodFormat, "", cmdutils.TextFormat, cmdutils.FormatUsage())
+	flags.StringVarP(
+		methodCmd, &methodTypeMatch, "", string(codescout.TextualTypes), cmdutils.TypeMatchUsage(),
+	)
 	flags.StringVarP(
 		methodCmd,
 		&methodOutputType,
@@ -97,33 +139,88 @@ func init() {
 
 func methodCmdRun(cmd *cobra.Command, args []string) error {
 	filePath := args[0]
-	validationErr := methodCommandValidation.CommandValidation(cmd)
-	if validationErr != nil {
-		return validationErr
+	methodConfig, configErr := methodConfigFromFlags(cmd)
+	if configErr != nil {
+		return configErr
 	}
-
-	methodConfig := codescout.MethodConfig{
-		Name:         methodName.Variable,
-		ParamTypes:   methodCommandValidation.GetNamedTypes(),
-		ReturnTypes:  methodReturnTypes.Variable,
-		Receiver:     methodReceiver.Variable,
-		IsPointerRec: flags.StringBoolToPointer(hasPointerReceiver.Variable),
-		Fields:       fieldsAccessed.Variable,
-		Methods:      methodsCalled.Variable,
-		NoParams:     flags.StringBoolToPointer(methodNoParams.Variable),
-		NoReturn:     flags.StringBoolToPointer(methodNoReturn.Variable),
-		NoFields:     flags.StringBoolToPointer(noFieldsAccessed.Variable),
-		NoMethods:    flags.StringBoolToPointer(noMethodsCalled.Variable),
-		Exact:        methodExact.Variable,
+	scoutFirst, scoutAll := codescout.ScoutMethod, codescout.ScoutMethods
+	if methodIndex.Variable != "" {
+		index, _, indexErr := cmdutils.OpenIndex(methodIndex.Variable, filePath)
+		if indexErr != nil {
+			return indexErr
+		}
+		scoutFirst, scoutAll = cmdutils.IndexScouts(index.ScoutMethod, index.ScoutMethods)
 	}
 	scoutContainer := cmdutils.NewScoutContainer(
-		codescout.ScoutMethod,
-		codescout.ScoutMethods,
+		scoutFirst,
+		scoutAll,
 		filePath,
 		methodOptions,
 		methodConfig,
 		"Method",
 		methodOutputType.Variable,
 	)
-	return scoutContainer.Display(methodVerbose.Variable)
+	return scoutContainer.WithFormat(methodFormat.Variable).Display(methodVerbose.Variable)
+}
+
+// methodConfigFromFlags validates the flags of the method command and returns the config they describe.
+func methodConfigFromFlags(cmd *cobra.Command) (codescout.MethodConfig, error) {
+	validationErr := methodCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return codescout.MethodConfig{}, validationErr
+	}
+	return methodConfigFromValues(cmdutils.NewFlagValues(cmd))
+}
+
+// methodConfigFromValues returns the config described by the values of the method command's
+// flags, read from the command or from a method rule of the config file.
+func methodConfigFromValues(values cmdutils.ConfigValues) (codescout.MethodConfig, error) {
+	params, paramsErr := cmdutils.ArgsToNamedTypes(values.Strings(methodParameterTypes.Name))
+	if paramsErr != nil {
+		return codescout.MethodConfig{}, paramsErr
+	}
+
+	typeParams, typeParamsErr := cmdutils.ArgsToNamedTypes(values.Strings(methodTypeParams.Name))
+	if typeParamsErr != nil {
+		return codescout.MethodConfig{}, typeParamsErr
+	}
+
+	excludedParams, excludedParamsErr := cmdutils.ArgsToNamedTypes(values.Strings(methodExclParams.Name))
+	if excludedParamsErr != nil {
+		return codescout.MethodConfig{}, excludedParamsErr
+	}
+
+	match := values.OneOf(methodMatch.Name, cmdutils.MatchModes, cmdutils.ExactMatch)
+	name, namePattern, patternMode := cmdutils.NamePattern(match, values.String(methodName.Name))
+	receiver, receiverPattern, _ := cmdutils.NamePattern(match, values.String(methodReceiver.Name))
+	methodConfig := codescout.MethodConfig{
+		Name:               name,
+		NamePattern:        namePattern,
+		PatternMode:        patternMode,
+		ParamTypes:         params,
+		ReturnTypes:        values.Strings(methodReturnTypes.Name),
+		Receiver:           receiver,
+		ReceiverPattern:    receiverPattern,
+		IsPointerRec:       values.OptionalBool(hasPointerReceiver.Name),
+		TypeParams:         typeParams,
+		NoTypeParams:       values.OptionalBool(methodNoTypeParams.Name),
+		IsGeneric:          values.OptionalBool(methodGeneric.Name),
+		Fields:             values.Strings(fieldsAccessed.Name),
+		Methods:            values.Strings(methodsCalled.Name),
+		ExcludeParamTypes:  excludedParams,
+		ExcludeReturnTypes: values.Strings(methodExclReturn.Name),
+		ExcludeFields:      values.Strings(exclFieldsAccessed.Name),
+		ExcludeMethods:     values.Strings(exclMethodsCalled.Name),
+		NoParams:           values.OptionalBool(methodNoParams.Name),
+		NoReturn:           values.OptionalBool(methodNoReturn.Name),
+		NoFields:           values.OptionalBool(noFieldsAccessed.Name),
+		NoMethods:          values.OptionalBool(noMethodsCalled.Name),
+		Exact:              values.Bool(methodExact.Name),
+		OrderedParams:      values.Bool(methodOrderedParams.Name),
+		TypeMatch:          codescout.TypeMatchMode(values.OneOf(methodTypeMatch.Name, cmdutils.TypeMatchModes, string(codescout.TextualTypes))),
+		Sort:               codescout.SortOrder(values.OneOf(methodSort.Name, cmdutils.SortOrders, string(codescout.PositionSort))),
+		Workers:            workers,
+		Partial:            values.Bool(methodPartial.Name),
+	}
+	return methodConfig, values.Err()
 }
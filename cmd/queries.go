@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/galactixx/codescout/internal/configfile"
+	"github.com/spf13/cobra"
+)
+
+var queriesCmd = &cobra.Command{
+	Use:   "queries",
+	Short: "List the saved queries",
+	Long:  "List the named queries saved in the config file, which are run with the run command",
+	Args:  cobra.NoArgs,
+	RunE:  queriesCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(queriesCmd)
+}
+
+// loadConfigFile reads the config file given with --config, or found from the working directory.
+func loadConfigFile() (*configfile.File, error) {
+	path := configPath
+	if path == "" {
+		found, findErr := configfile.Find(".")
+		if findErr != nil {
+			return nil, findErr
+		}
+		path = found
+	}
+	return configfile.Load(path)
+}
+
+func queriesCmdRun(cmd *cobra.Command, args []string) error {
+	file, err := loadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAME\tKIND\tPATHS\tDESCRIPTION")
+	for _, name := range file.QueryNames() {
+		query := file.Queries[name]
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", name, query.Kind, strings.Join(query.Paths, ","), query.Description)
+	}
+	return writer.Flush()
+}
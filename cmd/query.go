@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var queryFormat = flags.CommandFlag[string]{Name: "format"}
+
+var queryCmd = &cobra.Command{
+	Use:   "query <expr> <path>",
+	Short: "Find declarations matching a query expression",
+	Long: `Locate every declaration in a source file, directory, recursive ./... pattern or package matching
+a query expression, e.g. 'func(name =~ "^New", returns has error) or method(receiver = *Server and not calls Close)'`,
+	Args: cobra.ExactArgs(2),
+	RunE: queryCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(queryCmd)
+
+	flags.StringVarP(queryCmd, &queryFormat, "", cmdutils.TextFormat, cmdutils.FormatUsage())
+}
+
+func queryCmdRun(cmd *cobra.Command, args []string) error {
+	expr, filePath := args[0], args[1]
+	formatErr := cmdutils.FormatValidation(queryFormat)
+	if formatErr != nil {
+		return formatErr
+	}
+
+	matches, err := codescout.Query(filePath, expr)
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 && queryFormat.Variable == cmdutils.TextFormat {
+		fmt.Println("no declarations match the query")
+		return nil
+	}
+	return cmdutils.WriteMatches(os.Stdout, queryFormat.Variable, matches)
+}
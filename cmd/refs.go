@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	refsFormat  = flags.CommandFlag[string]{Name: "format"}
+	refsPartial = flags.CommandFlag[bool]{Name: "partial"}
+)
+
+var refsKinds = []string{
+	string(codescout.FuncSymbol),
+	string(codescout.MethodSymbol),
+	string(codescout.StructSymbol),
+	string(codescout.FieldSymbol),
+	string(codescout.ConstSymbol),
+}
+
+var refsCmd = &cobra.Command{
+	Use:   "refs <kind> <name> <path>",
+	Short: "Find the references to a declaration",
+	Long: fmt.Sprintf(`Locate a declaration in a source file, directory, recursive ./... pattern or package and list
+every use of it in the same code. The kind must be one of: %s. Methods and fields are named
+with their type, e.g. Store.Get`, strings.Join(refsKinds, ", ")),
+	Args: cobra.ExactArgs(3),
+	RunE: refsCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(refsCmd)
+
+	flags.StringVarP(refsCmd, &refsFormat, "", cmdutils.TextFormat, fmt.Sprintf(
+		"output format, must be one of: %s, %s", cmdutils.TextFormat, cmdutils.JSONFormat,
+	))
+	flags.BoolVarP(refsCmd, &refsPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+}
+
+func refsTarget(kind string, name string, path string) (codescout.Referable, error) {
+	receiver, member, isMember := strings.Cut(name, ".")
+	if (kind == string(codescout.MethodSymbol) || kind == string(codescout.FieldSymbol)) && !isMember {
+		return nil, fmt.Errorf("a %s must be named with its type, e.g. Type.Name", kind)
+	}
+
+	switch kind {
+	case string(codescout.FuncSymbol):
+		return codescout.ScoutFunction(path, codescout.FuncConfig{Name: name})
+	case string(codescout.MethodSymbol):
+		return codescout.ScoutMethod(path, codescout.MethodConfig{Name: member, Receiver: receiver})
+	case string(codescout.StructSymbol):
+		return codescout.ScoutStruct(path, codescout.StructConfig{Name: name})
+	case string(codescout.FieldSymbol):
+		structNode, err := codescout.ScoutStruct(path, codescout.StructConfig{Name: receiver})
+		if err != nil {
+			return nil, err
+		}
+		return structNode.FieldSymbol(member), nil
+	}
+	constNode, err := codescout.ScoutConst(path, codescout.ConstConfig{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return constNode.MemberSymbol(name), nil
+}
+
+func refsCmdRun(cmd *cobra.Command, args []string) error {
+	kind, name, filePath := args[0], args[1], args[2]
+	if !slices.Contains(refsKinds, kind) {
+		return fmt.Errorf("kind must be one of: %s", strings.Join(refsKinds, ", "))
+	}
+	if refsFormat.Variable != cmdutils.TextFormat && refsFormat.Variable != cmdutils.JSONFormat {
+		return fmt.Errorf("%s flag must be one of: %s, %s", refsFormat.Name, cmdutils.TextFormat, cmdutils.JSONFormat)
+	}
+
+	target, err := refsTarget(kind, name, filePath)
+	if err != nil {
+		return err
+	}
+	references, err := codescout.References(filePath, target, codescout.ReferencesConfig{Partial: refsPartial.Variable, Workers: workers})
+	if references == nil {
+		return err
+	}
+
+	if refsFormat.Variable == cmdutils.JSONFormat {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if encodeErr := encoder.Encode(references); encodeErr != nil {
+			return encodeErr
+		}
+		return err
+	}
+	for _, reference := range references {
+		fmt.Printf("%s:%d:%d\t%s\n", reference.Path, reference.Line, reference.Column, reference.Enclosing)
+	}
+	return err
+}
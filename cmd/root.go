@@ -15,6 +15,16 @@ quickly locate classes, functions, and other elements in any codebase.
 It makes code navigation and structure analysis fast and efficient`,
 }
 
+var (
+	workers    int
+	configPath string
+)
+
+func init() {
+	rootCmd.PersistentFlags().IntVar(&workers, "workers", 0, "number of files parsed and inspected concurrently, defaults to the number of CPUs")
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "path of the config file, found from the working directory by default")
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/spf13/cobra"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run <name>",
+	Short: "Run a saved query",
+	Long: `Run a named query saved in the config file, .codescout.yaml, .codescout.yml or .codescout.json
+in the working directory or its closest parent up to the repository root, on each of its paths`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+}
+
+// queryCommands are the commands running the saved queries of each kind.
+var queryCommands = map[string]*cobra.Command{
+	"func":   funcCmd,
+	"method": methodCmd,
+	"struct": structCmd,
+}
+
+func runCmdRun(cmd *cobra.Command, args []string) error {
+	file, err := loadConfigFile()
+	if err != nil {
+		return err
+	}
+	query, err := file.Query(args[0])
+	if err != nil {
+		return err
+	}
+
+	// Set the flags of the query as if they were passed to its command.
+	kindCmd := queryCommands[query.Kind]
+	values, err := query.FlagValues()
+	if err != nil {
+		return err
+	}
+	if query.Format != "" {
+		values = append(values, [2]string{"format", query.Format})
+	}
+	setErr := cmdutils.SetFlags(kindCmd, values)
+	if setErr != nil {
+		return fmt.Errorf("query %s: %w", args[0], setErr)
+	}
+
+	for _, path := range file.ResolvePaths(query.Paths) {
+		runErr := kindCmd.RunE(kindCmd, []string{path})
+		if runErr != nil {
+			return runErr
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/galactixx/codescout"
 	"github.com/galactixx/codescout/internal/cmdutils"
@@ -10,37 +11,93 @@ import (
 )
 
 var (
-	structName       = flags.CommandFlag[string]{Name: "name"}
-	structOutputType = flags.CommandFlag[string]{Name: "output"}
-	structFieldTypes = flags.CommandFlag[[]string]{Name: "fields"}
-	structNoFields   = flags.CommandFlag[string]{Name: "no-fields"}
-	structVerbose    = flags.CommandFlag[bool]{Name: "verbose"}
-	structExact      = flags.CommandFlag[bool]{Name: "exact"}
+	structName         = flags.CommandFlag[string]{Name: "name"}
+	structOutputType   = flags.CommandFlag[string]{Name: "output"}
+	structFieldTypes   = flags.CommandFlag[[]string]{Name: "fields"}
+	structNoFields     = flags.CommandFlag[string]{Name: "no-fields"}
+	structVerbose      = flags.CommandFlag[bool]{Name: "verbose"}
+	structExact        = flags.CommandFlag[bool]{Name: "exact"}
+	structPartial      = flags.CommandFlag[bool]{Name: "partial"}
+	structIndex        = flags.CommandFlag[string]{Name: "index"}
+	structSort         = flags.CommandFlag[string]{Name: "sort"}
+	structMatch        = flags.CommandFlag[string]{Name: "match"}
+	structFormat       = flags.CommandFlag[string]{Name: "format"}
+	structTypeMatch    = flags.CommandFlag[string]{Name: "type-match"}
+	structTypeParams   = flags.CommandFlag[[]string]{Name: "type-params"}
+	structNoTypeParams = flags.CommandFlag[string]{Name: "no-type-params"}
+	structGeneric      = flags.CommandFlag[string]{Name: "generic"}
+	structTags         = flags.CommandFlag[[]string]{Name: "tag"}
+	structMissingTag   = flags.CommandFlag[[]string]{Name: "missing-tag"}
+	structEmbeds       = flags.CommandFlag[[]string]{Name: "embeds"}
+	structExclEmbeds   = flags.CommandFlag[[]string]{Name: "exclude-embeds"}
+	structNoEmbeds     = flags.CommandFlag[string]{Name: "no-embeds"}
+	structExclFields   = flags.CommandFlag[[]string]{Name: "exclude-fields"}
+	structExclMethods  = flags.CommandFlag[[]string]{Name: "exclude-methods"}
 )
 
 var structOptions = cmdutils.OutputOptions[*codescout.StructNode]{Options: map[string]func(*codescout.StructNode) string{
-	"definition": func(node *codescout.StructNode) string { return node.Code() },
-	"body":       func(node *codescout.StructNode) string { return node.Body() },
-	"signature":  func(node *codescout.StructNode) string { return node.Signature() },
-	"comment":    func(node *codescout.StructNode) string { return node.Comments() },
+	"definition":  func(node *codescout.StructNode) string { return node.Code() },
+	"body":        func(node *codescout.StructNode) string { return node.Body() },
+	"signature":   func(node *codescout.StructNode) string { return node.Signature() },
+	"comment":     func(node *codescout.StructNode) string { return node.Comments() },
+	"type-params": func(node *codescout.StructNode) string { return cmdutils.TypeParamsOutput(node.TypeParams()) },
+	"tags":        structFieldTags,
+	"embeds":      func(node *codescout.StructNode) string { return strings.Join(node.Embeds(), "\n") },
+	"promoted":    structPromoted,
 }}
 
+func structPromoted(node *codescout.StructNode) string {
+	promoted := make([]string, 0, 5)
+	for _, field := range node.PromotedFields() {
+		promoted = append(promoted, fmt.Sprintf("%s %s", field.Name, field.Type))
+	}
+	for _, method := range node.PromotedMethods() {
+		promoted = append(promoted, method+"()")
+	}
+	return strings.Join(promoted, "\n")
+}
+
+func structFieldTags(node *codescout.StructNode) string {
+	tags := make([]string, 0, 5)
+	for _, field := range node.Fields() {
+		if field.Tag != "" {
+			tags = append(tags, fmt.Sprintf("%s `%s`", field.Name, field.Tag))
+		}
+	}
+	return strings.Join(tags, "\n")
+}
+
 var structBatchValidator = flags.BatchValidator{
-	EmptyValidators:      []flags.FlagValidator{&structName, &structFieldTypes},
-	StringBoolValidators: []*flags.CommandFlag[string]{&structNoFields},
+	EmptyValidators: []flags.FlagValidator{
+		&structName,
+		&structFieldTypes,
+		&structTags,
+		&structMissingTag,
+		&structEmbeds,
+		&structExclEmbeds,
+		&structTypeParams,
+		&structExclFields,
+		&structExclMethods,
+	},
+	StringBoolValidators: []*flags.CommandFlag[string]{&structNoFields, &structNoEmbeds, &structNoTypeParams, &structGeneric},
 }
 
 var structCommandValidation = cmdutils.CobraCommandVlidation[*codescout.StructNode]{
 	Validator:      structBatchValidator,
 	NamedTypesFlag: &structFieldTypes,
 	OutputTypeFlag: &structOutputType,
+	MatchModeFlag:  &structMatch,
+	FormatFlag:     &structFormat,
+	TypeMatchFlag:  &structTypeMatch,
+	SortFlag:       &structSort,
 	OutputOptions:  structOptions,
 }
 
 var structCmd = &cobra.Command{
 	Use:   "struct",
-	Short: "Find a single struct in a file",
-	Long:  "Locate and display a specific struct definition within a given source file",
+	Short: "Find a single struct in Go source",
+	Long:  "Locate and display a specific struct definition within a source file, directory, recursive ./... pattern or package",
+	Args:  cobra.ExactArgs(1),
 	RunE:  structCmdRun,
 }
 
@@ -50,8 +107,26 @@ func init() {
 	flags.StringVarP(structCmd, &structName, "n", "", "the struct name")
 	flags.StringSliceVarP(structCmd, &structFieldTypes, "f", make([]string, 0), "field names and types of struct")
 	flags.StringVarP(structCmd, &structNoFields, "s", "", "if the struct has no fields (true/false)")
+	flags.StringSliceVarP(structCmd, &structTags, "", make([]string, 0), "tags a field must have, as key or key:value (e.g. json:-)")
+	flags.StringSliceVarP(structCmd, &structMissingTag, "", make([]string, 0), "tag keys a field must be missing (e.g. db)")
+	flags.StringSliceVarP(structCmd, &structEmbeds, "e", make([]string, 0), "types the struct must embed")
+	flags.StringSliceVarP(structCmd, &structExclEmbeds, "", make([]string, 0), "types the struct must not embed")
+	flags.StringSliceVarP(structCmd, &structExclFields, "", make([]string, 0), "field names and types the struct must not have")
+	flags.StringSliceVarP(structCmd, &structExclMethods, "", make([]string, 0), "methods that must not be declared on the struct")
+	flags.StringVarP(structCmd, &structNoEmbeds, "", "", "if the struct embeds no types (true/false)")
+	flags.StringSliceVarP(structCmd, &structTypeParams, "", make([]string, 0), "type parameter names and constraints of struct")
+	flags.StringVarP(structCmd, &structNoTypeParams, "", "", "if the struct has no type parameters (true/false)")
+	flags.StringVarP(structCmd, &structGeneric, "", "", "if the struct is generic (true/false)")
 	flags.BoolVarP(structCmd, &structVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
 	flags.BoolVarP(structCmd, &structExact, "x", false, "if an exact match should occur with slice flags (true/false)")
+	flags.BoolVarP(structCmd, &structPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(structCmd, &structIndex, "", "", "answer from the index file at this path, updating it first (see index build)")
+	flags.StringVarP(structCmd, &structSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(structCmd, &structMatch, "", cmdutils.ExactMatch, cmdutils.MatchModeUsage())
+	flags.StringVarP(structCmd, &structFormat, "", cmdutils.TextFormat, cmdutils.FormatUsage())
+	flags.StringVarP(
+		structCmd, &structTypeMatch, "", string(codescout.TextualTypes), cmdutils.TypeMatchUsage(),
+	)
 	flags.StringVarP(
 		structCmd,
 		&structOutputType,
@@ -63,25 +138,84 @@ func init() {
 
 func structCmdRun(cmd *cobra.Command, args []string) error {
 	filePath := args[0]
-	validationErr := structCommandValidation.CommandValidation(cmd)
-	if validationErr != nil {
-		return validationErr
+	structConfig, configErr := structConfigFromFlags(cmd)
+	if configErr != nil {
+		return configErr
 	}
-
-	structConfig := codescout.StructConfig{
-		Name:       structName.Variable,
-		FieldTypes: structCommandValidation.GetNamedTypes(),
-		NoFields:   flags.StringBoolToPointer(structNoFields.Variable),
-		Exact:      structExact.Variable,
+	scoutFirst, scoutAll := codescout.ScoutStruct, codescout.ScoutStructs
+	if structIndex.Variable != "" {
+		index, _, indexErr := cmdutils.OpenIndex(structIndex.Variable, filePath)
+		if indexErr != nil {
+			return indexErr
+		}
+		scoutFirst, scoutAll = cmdutils.IndexScouts(index.ScoutStruct, index.ScoutStructs)
 	}
 	scoutContainer := cmdutils.NewScoutContainer(
-		codescout.ScoutStruct,
-		codescout.ScoutStructs,
+		scoutFirst,
+		scoutAll,
 		filePath,
 		structOptions,
 		structConfig,
 		"Struct",
 		structOutputType.Variable,
 	)
-	return scoutContainer.Display(structVerbose.Variable)
+	return scoutContainer.WithFormat(structFormat.Variable).Display(structVerbose.Variable)
+}
+
+// structConfigFromFlags validates the flags of the struct command and returns the config they describe.
+func structConfigFromFlags(cmd *cobra.Command) (codescout.StructConfig, error) {
+	validationErr := structCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return codescout.StructConfig{}, validationErr
+	}
+	return structConfigFromValues(cmdutils.NewFlagValues(cmd))
+}
+
+// structConfigFromValues returns the config described by the values of the struct command's
+// flags, read from the command or from a struct rule of the config file.
+func structConfigFromValues(values cmdutils.ConfigValues) (codescout.StructConfig, error) {
+	fields, fieldsErr := cmdutils.ArgsToNamedTypes(values.Strings(structFieldTypes.Name))
+	if fieldsErr != nil {
+		return codescout.StructConfig{}, fieldsErr
+	}
+
+	tagFilters, tagErr := cmdutils.ArgsToTagFilters(values.Strings(structTags.Name), values.Strings(structMissingTag.Name))
+	if tagErr != nil {
+		return codescout.StructConfig{}, tagErr
+	}
+
+	typeParams, typeParamsErr := cmdutils.ArgsToNamedTypes(values.Strings(structTypeParams.Name))
+	if typeParamsErr != nil {
+		return codescout.StructConfig{}, typeParamsErr
+	}
+
+	excludedFields, excludedFieldsErr := cmdutils.ArgsToNamedTypes(values.Strings(structExclFields.Name))
+	if excludedFieldsErr != nil {
+		return codescout.StructConfig{}, excludedFieldsErr
+	}
+
+	match := values.OneOf(structMatch.Name, cmdutils.MatchModes, cmdutils.ExactMatch)
+	name, namePattern, patternMode := cmdutils.NamePattern(match, values.String(structName.Name))
+	structConfig := codescout.StructConfig{
+		Name:              name,
+		NamePattern:       namePattern,
+		PatternMode:       patternMode,
+		FieldTypes:        fields,
+		NoFields:          values.OptionalBool(structNoFields.Name),
+		ExcludeFieldTypes: excludedFields,
+		ExcludeMethods:    values.Strings(structExclMethods.Name),
+		Tags:              tagFilters,
+		Embeds:            values.Strings(structEmbeds.Name),
+		ExcludeEmbeds:     values.Strings(structExclEmbeds.Name),
+		NoEmbeds:          values.OptionalBool(structNoEmbeds.Name),
+		TypeParams:        typeParams,
+		NoTypeParams:      values.OptionalBool(structNoTypeParams.Name),
+		IsGeneric:         values.OptionalBool(structGeneric.Name),
+		Exact:             values.Bool(structExact.Name),
+		TypeMatch:         codescout.TypeMatchMode(values.OneOf(structTypeMatch.Name, cmdutils.TypeMatchModes, string(codescout.TextualTypes))),
+		Sort:              codescout.SortOrder(values.OneOf(structSort.Name, cmdutils.SortOrders, string(codescout.PositionSort))),
+		Workers:           workers,
+		Partial:           values.Bool(structPartial.Name),
+	}
+	return structConfig, values.Err()
 }
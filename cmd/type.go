@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	typeName       = flags.CommandFlag[string]{Name: "name"}
+	typeKind       = flags.CommandFlag[string]{Name: "kind"}
+	typeUnderlying = flags.CommandFlag[string]{Name: "underlying"}
+	typeOutputType = flags.CommandFlag[string]{Name: "output"}
+	typeMethods    = flags.CommandFlag[[]string]{Name: "methods"}
+	typeNoMethods  = flags.CommandFlag[string]{Name: "no-methods"}
+	typeVerbose    = flags.CommandFlag[bool]{Name: "verbose"}
+	typeExact      = flags.CommandFlag[bool]{Name: "exact"}
+	typePartial    = flags.CommandFlag[bool]{Name: "partial"}
+	typeSort       = flags.CommandFlag[string]{Name: "sort"}
+	typeMatch      = flags.CommandFlag[string]{Name: "match"}
+)
+
+var typeOptions = cmdutils.OutputOptions[*codescout.TypeNode]{Options: map[string]func(*codescout.TypeNode) string{
+	"definition": func(node *codescout.TypeNode) string { return node.Code() },
+	"signature":  func(node *codescout.TypeNode) string { return node.Signature() },
+	"comment":    func(node *codescout.TypeNode) string { return node.Comments() },
+	"kind":       func(node *codescout.TypeNode) string { return string(node.Kind()) },
+	"underlying": func(node *codescout.TypeNode) string { return node.Underlying() },
+	"methods":    func(node *codescout.TypeNode) string { return cmdutils.JoinAttrs(node.MethodNames()) },
+}}
+
+var typeBatchValidator = flags.BatchValidator{
+	EmptyValidators:      []flags.FlagValidator{&typeName, &typeKind, &typeUnderlying, &typeMethods},
+	StringBoolValidators: []*flags.CommandFlag[string]{&typeNoMethods},
+}
+
+var typeCommandValidation = cmdutils.CobraCommandVlidation[*codescout.TypeNode]{
+	Validator:      typeBatchValidator,
+	OutputTypeFlag: &typeOutputType,
+	MatchModeFlag:  &typeMatch,
+	SortFlag:       &typeSort,
+	OutputOptions:  typeOptions,
+}
+
+var typeCmd = &cobra.Command{
+	Use:   "type",
+	Short: "Find a single declared type in Go source",
+	Long:  "Locate and display any declared type, including aliases and non-struct types, within a source file, directory, recursive ./... pattern or package",
+	Args:  cobra.ExactArgs(1),
+	RunE:  typeCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(typeCmd)
+
+	flags.StringVarP(typeCmd, &typeName, "n", "", "the type name")
+	flags.StringVarP(typeCmd, &typeKind, "k", "", fmt.Sprintf("kind of the type, must be one of: %v", codescout.TypeKinds))
+	flags.StringVarP(typeCmd, &typeUnderlying, "u", "", "underlying type expression of the type")
+	flags.StringSliceVarP(typeCmd, &typeMethods, "m", make([]string, 0), "names of methods declared on the type")
+	flags.StringVarP(typeCmd, &typeNoMethods, "s", "", "if the type has no methods (true/false)")
+	flags.BoolVarP(typeCmd, &typeVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
+	flags.BoolVarP(typeCmd, &typeExact, "x", false, "if an exact match should occur with slice flags (true/false)")
+	flags.BoolVarP(typeCmd, &typePartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(typeCmd, &typeSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(typeCmd, &typeMatch, "", cmdutils.ExactMatch, cmdutils.MatchModeUsage())
+	flags.StringVarP(
+		typeCmd,
+		&typeOutputType,
+		"o",
+		"definition",
+		fmt.Sprintf("part of type to output, must be one of: %s", typeOptions.ToOptionString()),
+	)
+}
+
+func typeCmdRun(cmd *cobra.Command, args []string) error {
+	filePath := args[0]
+	validationErr := typeCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return validationErr
+	}
+
+	name, namePattern, patternMode := cmdutils.NamePattern(typeMatch.Variable, typeName.Variable)
+	typeConfig := codescout.TypeConfig{
+		Name:        name,
+		NamePattern: namePattern,
+		PatternMode: patternMode,
+		Kind:        codescout.TypeKind(typeKind.Variable),
+		Underlying:  typeUnderlying.Variable,
+		Methods:     typeMethods.Variable,
+		NoMethods:   flags.StringBoolToPointer(typeNoMethods.Variable),
+		Exact:       typeExact.Variable,
+		Sort:        codescout.SortOrder(typeSort.Variable),
+		Workers:     workers,
+		Partial:     typePartial.Variable,
+	}
+	scoutContainer := cmdutils.NewScoutContainer(
+		codescout.ScoutType,
+		codescout.ScoutTypes,
+		filePath,
+		typeOptions,
+		typeConfig,
+		"Type",
+		typeOutputType.Variable,
+	)
+	return scoutContainer.Display(typeVerbose.Variable)
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/cmdutils"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	varName       = flags.CommandFlag[string]{Name: "name"}
+	varType       = flags.CommandFlag[string]{Name: "type"}
+	varOutputType = flags.CommandFlag[string]{Name: "output"}
+	varExported   = flags.CommandFlag[string]{Name: "exported"}
+	varHasValue   = flags.CommandFlag[string]{Name: "has-value"}
+	varVerbose    = flags.CommandFlag[bool]{Name: "verbose"}
+	varPartial    = flags.CommandFlag[bool]{Name: "partial"}
+	varSort       = flags.CommandFlag[string]{Name: "sort"}
+	varMatch      = flags.CommandFlag[string]{Name: "match"}
+)
+
+var varOptions = cmdutils.OutputOptions[*codescout.VarNode]{Options: map[string]func(*codescout.VarNode) string{
+	"definition": func(node *codescout.VarNode) string { return node.Code() },
+	"comment":    func(node *codescout.VarNode) string { return node.Comments() },
+	"type":       func(node *codescout.VarNode) string { return node.Type() },
+	"value":      func(node *codescout.VarNode) string { return node.Value() },
+}}
+
+var varBatchValidator = flags.BatchValidator{
+	EmptyValidators:      []flags.FlagValidator{&varName, &varType},
+	StringBoolValidators: []*flags.CommandFlag[string]{&varExported, &varHasValue},
+}
+
+var varCommandValidation = cmdutils.CobraCommandVlidation[*codescout.VarNode]{
+	Validator:      varBatchValidator,
+	OutputTypeFlag: &varOutputType,
+	MatchModeFlag:  &varMatch,
+	SortFlag:       &varSort,
+	OutputOptions:  varOptions,
+}
+
+var varCmd = &cobra.Command{
+	Use:   "var",
+	Short: "Find a single variable in Go source",
+	Long:  "Locate and display a package-level variable within a source file, directory, recursive ./... pattern or package",
+	Args:  cobra.ExactArgs(1),
+	RunE:  varCmdRun,
+}
+
+func init() {
+	rootCmd.AddCommand(varCmd)
+
+	flags.StringVarP(varCmd, &varName, "n", "", "the variable name")
+	flags.StringVarP(varCmd, &varType, "t", "", "declared type of the variable")
+	flags.StringVarP(varCmd, &varExported, "e", "", "if the variable is exported (true/false)")
+	flags.StringVarP(varCmd, &varHasValue, "a", "", "if the variable is assigned a value (true/false)")
+	flags.BoolVarP(varCmd, &varVerbose, "v", false, "whether to print all occurrences or just the first (true/false)")
+	flags.BoolVarP(varCmd, &varPartial, "", false, "scout files with syntax errors and report the errors (true/false)")
+	flags.StringVarP(varCmd, &varSort, "", string(codescout.PositionSort), cmdutils.SortUsage())
+	flags.StringVarP(varCmd, &varMatch, "", cmdutils.ExactMatch, cmdutils.MatchModeUsage())
+	flags.StringVarP(
+		varCmd,
+		&varOutputType,
+		"o",
+		"definition",
+		fmt.Sprintf("part of variable to output, must be one of: %s", varOptions.ToOptionString()),
+	)
+}
+
+func varCmdRun(cmd *cobra.Command, args []string) error {
+	filePath := args[0]
+	validationErr := varCommandValidation.CommandValidation(cmd)
+	if validationErr != nil {
+		return validationErr
+	}
+
+	name, namePattern, patternMode := cmdutils.NamePattern(varMatch.Variable, varName.Variable)
+	varConfig := codescout.VarConfig{
+		Name:        name,
+		NamePattern: namePattern,
+		PatternMode: patternMode,
+		Type:        varType.Variable,
+		Exported:    flags.StringBoolToPointer(varExported.Variable),
+		HasValue:    flags.StringBoolToPointer(varHasValue.Variable),
+		Sort:        codescout.SortOrder(varSort.Variable),
+		Workers:     workers,
+		Partial:     varPartial.Variable,
+	}
+	scoutContainer := cmdutils.NewScoutContainer(
+		codescout.ScoutVar,
+		codescout.ScoutVars,
+		filePath,
+		varOptions,
+		varConfig,
+		"Variable",
+		varOutputType.Variable,
+	)
+	return scoutContainer.Display(varVerbose.Variable)
+}
@@ -1,48 +1,119 @@
+// Package codescout finds the declarations in Go source that match a configuration, and
+// reports their metadata and source.
+//
+// Every scouting function has a WithContext variant, which stops scouting once its context
+// is cancelled and returns the context's error, or hands it out as the last pair of a stream.
 package codescout
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/fs"
+	"reflect"
 )
 
 // NamedType represents a named parameter or field with its associated type.
 type NamedType struct {
-	Name string
-	Type string
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Tag is the unquoted struct tag of a field, e.g. `json:"id,omitempty"`.
+	Tag string `json:"tag,omitempty"`
+	// Embedded is true for an embedded struct field, whose Name is the implicit field name.
+	Embedded bool `json:"embedded,omitempty"`
+}
+
+// StructTag returns the field's tag, which follows reflect.StructTag conventions.
+func (n NamedType) StructTag() reflect.StructTag { return reflect.StructTag(n.Tag) }
+
+// TagFilter matches struct fields by the key and value of their tags.
+type TagFilter struct {
+	// Key of the tag, e.g. "json".
+	Key string
+	// Value the tag must have, e.g. "-" or "id,omitempty"; any value matches when empty.
+	Value string
+	// Name of the field the filter applies to; any field matches when empty.
+	Field string
+	// If true, the filter matches a field that does not have the tag key.
+	Missing bool
 }
 
 // FuncConfig holds configuration for scouting a function in source code.
 type FuncConfig struct {
 	// Name of the function.
 	Name string
+	// Glob or regular expression the name must match, interpreted according to PatternMode.
+	NamePattern string
+	// How NamePattern is interpreted, GlobPattern unless specified.
+	PatternMode PatternMode
 	// Expected parameter types (a subset unless exact is specified).
 	ParamTypes []NamedType
 	// Expected return types (a subset unless exact is specified).
 	ReturnTypes []string
+	// Parameter types the function must not take, with optional names. Exact does not apply.
+	ExcludeParamTypes []NamedType
+	// Types the function must not return. Exact does not apply.
+	ExcludeReturnTypes []string
 	// If true, function should have no parameters.
 	NoParams *bool
 	// If true, function should have no return values.
 	NoReturn *bool
+	// Type parameter names and constraints, e.g. {Name: "T", Type: "comparable"} (a subset
+	// unless exact is specified).
+	TypeParams []NamedType
+	// If true, function should not declare type parameters.
+	NoTypeParams *bool
+	// If true, function must be generic; if false, it must not be.
+	IsGeneric *bool
 	// If true, all criteria slices must match exactly.
 	Exact bool
+	// If true, ParamTypes must be the leading parameters in the order given, so that
+	// {Name: "ctx", Type: "context.Context"} only matches a first parameter ctx. With Exact,
+	// ParamTypes must be every parameter in order.
+	OrderedParams bool
+	// How configured types are compared with declared types, TextualTypes unless specified.
+	// IdenticalTypes and AssignableTypes type-check the scouted package with go/types.
+	TypeMatch TypeMatchMode
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Number of files parsed and inspected concurrently, runtime.GOMAXPROCS(0) unless specified.
+	// The matches are the same for any number of workers.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
 }
 
 // MethodConfig holds configuration for scouting a method in source code.
 type MethodConfig struct {
 	// Name of the method.
 	Name string
+	// Glob or regular expression the name must match, interpreted according to PatternMode.
+	NamePattern string
 	// Expected parameter types (a subset unless exact is specified).
 	ParamTypes []NamedType
 	// Expected return types (a subset unless exact is specified).
 	ReturnTypes []string
+	// Parameter types the method must not take, with optional names. Exact does not apply.
+	ExcludeParamTypes []NamedType
+	// Types the method must not return. Exact does not apply.
+	ExcludeReturnTypes []string
 	// Type of the receiver.
 	Receiver string
+	// Glob or regular expression the receiver type must match, interpreted according to PatternMode.
+	ReceiverPattern string
+	// How NamePattern and ReceiverPattern are interpreted, GlobPattern unless specified.
+	PatternMode PatternMode
 	// If true, method must have pointer receiver.
 	IsPointerRec *bool
 	// Struct fields that must be accessed within method.
 	Fields []string
 	// Struct methods that must be called within method.
 	Methods []string
+	// Struct fields that must not be accessed within method.
+	ExcludeFields []string
+	// Struct methods that must not be called within method.
+	ExcludeMethods []string
 	// If true, method should have no parameters.
 	NoParams *bool
 	// If true, method should have no return values.
@@ -51,74 +122,911 @@ type MethodConfig struct {
 	NoFields *bool
 	// If true, the method must not call any of the struct methods.
 	NoMethods *bool
+	// Type parameter names of a generic receiver and the constraints declared by its type,
+	// e.g. {Name: "T", Type: "any"} for "func (s *Stack[T]) Push" (a subset unless exact is
+	// specified).
+	TypeParams []NamedType
+	// If true, method should not belong to a generic receiver type.
+	NoTypeParams *bool
+	// If true, method must belong to a generic receiver type, e.g. "func (s *Stack[T]) Push";
+	// if false, it must not.
+	IsGeneric *bool
 	// If true, all criteria slices must match exactly.
 	Exact bool
+	// If true, ParamTypes must be the leading parameters in the order given, so that
+	// {Name: "ctx", Type: "context.Context"} only matches a first parameter ctx. With Exact,
+	// ParamTypes must be every parameter in order.
+	OrderedParams bool
+	// How configured types are compared with declared types, TextualTypes unless specified.
+	// IdenticalTypes and AssignableTypes type-check the scouted package with go/types.
+	TypeMatch TypeMatchMode
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
 }
 
 // StructConfig holds configuration for scouting a struct type in source code.
 type StructConfig struct {
 	// Name of the struct.
 	Name string
+	// Glob or regular expression the name must match, interpreted according to PatternMode.
+	NamePattern string
+	// How NamePattern is interpreted, GlobPattern unless specified.
+	PatternMode PatternMode
 	// Expected field names and types.
 	FieldTypes []NamedType
 	// If true, struct should not have fields.
 	NoFields *bool
+	// Field types the struct must not have, with optional names. Exact does not apply.
+	ExcludeFieldTypes []NamedType
+	// Names of methods that must not be declared on the struct.
+	ExcludeMethods []string
+	// Tag filters that must each be satisfied by at least one field.
+	Tags []TagFilter
+	// Types the struct must embed, e.g. "sync.Mutex" or "*Base" (a subset unless exact is specified).
+	// A pointer embed also matches its element type, so "Base" matches an embedded "*Base".
+	Embeds []string
+	// Types the struct must not embed.
+	ExcludeEmbeds []string
+	// If true, struct should not embed any types.
+	NoEmbeds *bool
+	// Type parameter names and constraints, e.g. {Name: "T", Type: "comparable"} (a subset
+	// unless exact is specified).
+	TypeParams []NamedType
+	// If true, struct should not declare type parameters.
+	NoTypeParams *bool
+	// If true, struct must be generic; if false, it must not be.
+	IsGeneric *bool
+	// If true, all criteria slices must match exactly.
+	Exact bool
+	// How configured types are compared with declared types, TextualTypes unless specified.
+	// IdenticalTypes and AssignableTypes type-check the scouted package with go/types.
+	TypeMatch TypeMatchMode
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
+}
+
+// MethodSignature describes a method declared by an interface.
+type MethodSignature struct {
+	// Name of the method.
+	Name string
+	// Parameter names and types of the method.
+	ParamTypes []NamedType
+	// Return types of the method.
+	ReturnTypes []string
+}
+
+// InterfaceConfig holds configuration for scouting an interface type in source code.
+type InterfaceConfig struct {
+	// Name of the interface.
+	Name string
+	// Glob or regular expression the name must match, interpreted according to PatternMode.
+	NamePattern string
+	// How NamePattern is interpreted, GlobPattern unless specified.
+	PatternMode PatternMode
+	// Method signatures the interface must declare (a subset unless exact is specified).
+	Methods []MethodSignature
+	// Interfaces that must be embedded (a subset unless exact is specified).
+	Embeds []string
+	// Type-set constraint terms, e.g. "~int" or "string" (a subset unless exact is specified).
+	TypeSet []string
+	// If true, interface should not declare methods.
+	NoMethods *bool
+	// If true, interface should not embed other interfaces.
+	NoEmbeds *bool
 	// If true, all criteria slices must match exactly.
 	Exact bool
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
+}
+
+// TypeKind describes the kind of type a declared type is defined as.
+type TypeKind string
+
+const (
+	// KindBasic is a type defined as a predeclared basic type, e.g. "type Celsius float64".
+	KindBasic TypeKind = "basic"
+	// KindNamed is a type defined as another named type, e.g. "type Level log.Level".
+	KindNamed TypeKind = "named"
+	// KindStruct is a type defined as a struct.
+	KindStruct TypeKind = "struct"
+	// KindInterface is a type defined as an interface.
+	KindInterface TypeKind = "interface"
+	// KindFunc is a type defined as a function, e.g. "type HandlerFunc func(w Writer)".
+	KindFunc TypeKind = "func"
+	// KindSlice is a type defined as a slice, e.g. "type IDs []string".
+	KindSlice TypeKind = "slice"
+	// KindArray is a type defined as an array.
+	KindArray TypeKind = "array"
+	// KindMap is a type defined as a map.
+	KindMap TypeKind = "map"
+	// KindChan is a type defined as a channel.
+	KindChan TypeKind = "chan"
+	// KindPointer is a type defined as a pointer.
+	KindPointer TypeKind = "pointer"
+	// KindAlias is a type alias, e.g. "type X = Y".
+	KindAlias TypeKind = "alias"
+)
+
+// TypeKinds lists every TypeKind that a TypeNode may report.
+var TypeKinds = []TypeKind{
+	KindBasic, KindNamed, KindStruct, KindInterface, KindFunc, KindSlice, KindArray, KindMap, KindChan, KindPointer, KindAlias,
+}
+
+// TypeConfig holds configuration for scouting any declared type in source code.
+type TypeConfig struct {
+	// Name of the type.
+	Name string
+	// Glob or regular expression the name must match, interpreted according to PatternMode.
+	NamePattern string
+	// How NamePattern is interpreted, GlobPattern unless specified.
+	PatternMode PatternMode
+	// Kind of the type, e.g. KindFunc or KindAlias.
+	Kind TypeKind
+	// Underlying type expression, e.g. "float64" or "[]string".
+	Underlying string
+	// Methods that must be declared on the type (a subset unless exact is specified).
+	Methods []string
+	// If true, type should not have methods.
+	NoMethods *bool
+	// If true, all criteria slices must match exactly.
+	Exact bool
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
+}
+
+// ConstConfig holds configuration for scouting package-level constants in source code.
+// Constants declared in an iota block are grouped into a single enum node.
+type ConstConfig struct {
+	// Name of the constant, enum type or any of the enum's members.
+	Name string
+	// Glob or regular expression the name must match, interpreted according to PatternMode.
+	NamePattern string
+	// How NamePattern is interpreted, GlobPattern unless specified.
+	PatternMode PatternMode
+	// Declared type of the constant or enum.
+	Type string
+	// If true, constant must be exported.
+	Exported *bool
+	// If true, every constant must have its value written explicitly rather than
+	// implicitly repeating the previous expression of its block.
+	HasValue *bool
+	// If true, constant must be an iota enum group.
+	IsEnum *bool
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
+}
+
+// VarConfig holds configuration for scouting package-level variables in source code.
+type VarConfig struct {
+	// Name of the variable.
+	Name string
+	// Glob or regular expression the name must match, interpreted according to PatternMode.
+	NamePattern string
+	// How NamePattern is interpreted, GlobPattern unless specified.
+	PatternMode PatternMode
+	// Declared type of the variable.
+	Type string
+	// If true, variable must be exported.
+	Exported *bool
+	// If true, variable must be assigned a value in its declaration.
+	HasValue *bool
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
+}
+
+// ImplementsConfig holds configuration for finding the named types that implement an interface.
+type ImplementsConfig struct {
+	// Interface to satisfy, either declared in the scouted code (e.g. "Store") or
+	// a package-qualified interface such as "io.Reader" or "net/http.Handler".
+	Interface string
+	// Order the matches are returned in, PositionSort unless specified.
+	Sort SortOrder
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the matches.
+	Partial bool
+}
+
+// CallGraphConfig holds configuration for building a static call graph.
+type CallGraphConfig struct {
+	// If true, only calls to functions and methods declared in the scouted code are
+	// included, leaving out calls into the standard library and other imported packages.
+	LocalOnly bool
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the declarations that
+	// did parse, and the syntax errors are returned as ParseErrors alongside the graph.
+	Partial bool
+}
+
+// ReferencesConfig holds configuration for finding the references to a symbol.
+type ReferencesConfig struct {
+	// Workers is as in FuncConfig.
+	Workers int
+	// If true, files with syntax errors are still scouted for the uses that did parse,
+	// and the syntax errors are returned as ParseErrors alongside the references.
+	Partial bool
 }
 
 // getFirstOccurrence returns the first matching node found by the inspector.
-func getFirstOccurrence[T any](preScout preScoutSetup[T], symbol string) (*T, error) {
+func getFirstOccurrence[T any](ctx context.Context, preScout preScoutSetup[T], symbol string) (*T, error) {
 	inspector, err := preScout.initializeInspect()
 	if err != nil {
 		return nil, err
 	}
 
-	inspector.inspect()
+	// Stop at the first match when matches are found in their configured order.
+	var first *T
+	if streamed, ok := inspector.(streamer[T]); ok {
+		_ = streamed.stream(func(node *T) bool {
+			first = node
+			return false
+		})
+	}
+
+	inspectErr := inspector.inspect(ctx)
+	if inspectErr != nil && !isPartialResult(inspectErr) {
+		return nil, inspectErr
+	}
+	if first != nil {
+		return first, inspectErr
+	}
 	if len(inspector.getNodes()) == 0 {
+		if inspectErr != nil {
+			return nil, inspectErr
+		}
 		errMsg := fmt.Sprintf("no %s was found based on configuration", symbol)
 		err := errors.New(errMsg)
 		return nil, err
 	}
-	return inspector.getNodes()[0], nil
+	return inspector.getNodes()[0], inspectErr
 }
 
 // getAllOccurrences returns all matching nodes found by the inspector.
-func getAllOccurrences[T any](preScout preScoutSetup[T]) ([]*T, error) {
+func getAllOccurrences[T any](ctx context.Context, preScout preScoutSetup[T]) ([]*T, error) {
 	inspector, err := preScout.initializeInspect()
 	if err != nil {
 		return nil, err
 	}
-	inspector.inspect()
-	return inspector.getNodes(), nil
+	inspectErr := inspector.inspect(ctx)
+	if inspectErr != nil && !isPartialResult(inspectErr) {
+		return nil, inspectErr
+	}
+	return inspector.getNodes(), inspectErr
+}
+
+// streamOccurrences returns the matching nodes found by the inspector as a sequence, handed out
+// as they are found when the inspector supports it. The error ending the inspection, or the
+// ParseErrors of a partial inspection, is handed out last with a nil node.
+func streamOccurrences[T any](ctx context.Context, preScout preScoutSetup[T]) Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		inspector, err := preScout.initializeInspect()
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		stopped := false
+		if streamed, ok := inspector.(streamer[T]); ok {
+			streamErr := streamed.stream(func(node *T) bool {
+				stopped = !yield(node, nil)
+				return !stopped
+			})
+			if streamErr != nil {
+				yield(nil, streamErr)
+				return
+			}
+		}
+
+		inspectErr := inspector.inspect(ctx)
+		if stopped {
+			return
+		}
+		for _, node := range inspector.getNodes() {
+			if stopped = !yield(node, nil); stopped {
+				return
+			}
+		}
+		if inspectErr != nil {
+			yield(nil, inspectErr)
+		}
+	}
 }
 
 // ScoutFunction returns the first function in the given path matching the config.
+// The path may be a Go file, a directory, a recursive "./..." pattern or a package import path.
+// A syntax error is returned as a *ParseError, unless the config enables Partial.
 func ScoutFunction(path string, config FuncConfig) (*FuncNode, error) {
-	return getFirstOccurrence(funcScoutSetup{Path: path, Config: config}, "function")
+	return ScoutFunctionWithContext(context.Background(), path, config)
+}
+
+// ScoutFunctionWithContext is ScoutFunction with a context.
+func ScoutFunctionWithContext(ctx context.Context, path string, config FuncConfig) (*FuncNode, error) {
+	return getFirstOccurrence(ctx, funcScoutSetup{Path: path, Config: config}, "function")
 }
 
 // ScoutFunctions returns all functions in the given path matching the config.
 func ScoutFunctions(path string, config FuncConfig) ([]*FuncNode, error) {
-	return getAllOccurrences(funcScoutSetup{Path: path, Config: config})
+	return ScoutFunctionsWithContext(context.Background(), path, config)
+}
+
+// ScoutFunctionsWithContext is ScoutFunctions with a context.
+func ScoutFunctionsWithContext(ctx context.Context, path string, config FuncConfig) ([]*FuncNode, error) {
+	return getAllOccurrences(ctx, funcScoutSetup{Path: path, Config: config})
+}
+
+// StreamFunctions returns the functions in the given path matching the config as a sequence, which
+// hands out each match as soon as it is found, in position order, and stops scouting once the
+// consumer stops. Only the files parsed ahead of the consumer are held in memory, so the first
+// match of a large scan arrives early. An error ends the sequence, handed out with a nil node,
+// as do the ParseErrors of a config enabling Partial. Sort must be left unset or PositionSort.
+func StreamFunctions(path string, config FuncConfig) Seq2[*FuncNode, error] {
+	return StreamFunctionsWithContext(context.Background(), path, config)
+}
+
+// StreamFunctionsWithContext is StreamFunctions with a context.
+func StreamFunctionsWithContext(ctx context.Context, path string, config FuncConfig) Seq2[*FuncNode, error] {
+	return streamOccurrences(ctx, funcScoutSetup{Path: path, Config: config})
 }
 
 // ScoutStruct returns the first struct in the given path matching the config.
 func ScoutStruct(path string, config StructConfig) (*StructNode, error) {
-	return getFirstOccurrence(structScoutSetup{Path: path, Config: config}, "struct")
+	return ScoutStructWithContext(context.Background(), path, config)
+}
+
+// ScoutStructWithContext is ScoutStruct with a context.
+func ScoutStructWithContext(ctx context.Context, path string, config StructConfig) (*StructNode, error) {
+	return getFirstOccurrence(ctx, structScoutSetup{Path: path, Config: config}, "struct")
 }
 
 // ScoutStructs returns all structs in the given path matching the config.
 func ScoutStructs(path string, config StructConfig) ([]*StructNode, error) {
-	return getAllOccurrences(structScoutSetup{Path: path, Config: config})
+	return ScoutStructsWithContext(context.Background(), path, config)
+}
+
+// ScoutStructsWithContext is ScoutStructs with a context.
+func ScoutStructsWithContext(ctx context.Context, path string, config StructConfig) ([]*StructNode, error) {
+	return getAllOccurrences(ctx, structScoutSetup{Path: path, Config: config})
 }
 
 // ScoutMethod returns the first method in the given path matching the config.
 func ScoutMethod(path string, config MethodConfig) (*MethodNode, error) {
-	return getFirstOccurrence(methodScoutSetup{Path: path, Config: config}, "method")
+	return ScoutMethodWithContext(context.Background(), path, config)
+}
+
+// ScoutMethodWithContext is ScoutMethod with a context.
+func ScoutMethodWithContext(ctx context.Context, path string, config MethodConfig) (*MethodNode, error) {
+	return getFirstOccurrence(ctx, methodScoutSetup{Path: path, Config: config}, "method")
 }
 
 // ScoutMethods returns all methods in the given path matching the config.
 func ScoutMethods(path string, config MethodConfig) ([]*MethodNode, error) {
-	return getAllOccurrences(methodScoutSetup{Path: path, Config: config})
+	return ScoutMethodsWithContext(context.Background(), path, config)
+}
+
+// ScoutMethodsWithContext is ScoutMethods with a context.
+func ScoutMethodsWithContext(ctx context.Context, path string, config MethodConfig) ([]*MethodNode, error) {
+	return getAllOccurrences(ctx, methodScoutSetup{Path: path, Config: config})
+}
+
+// StreamMethods returns the methods in the given path matching the config as a sequence, handed
+// out as they are found in the same way as StreamFunctions.
+func StreamMethods(path string, config MethodConfig) Seq2[*MethodNode, error] {
+	return StreamMethodsWithContext(context.Background(), path, config)
+}
+
+// StreamMethodsWithContext is StreamMethods with a context.
+func StreamMethodsWithContext(ctx context.Context, path string, config MethodConfig) Seq2[*MethodNode, error] {
+	return streamOccurrences(ctx, methodScoutSetup{Path: path, Config: config})
+}
+
+// ScoutInterface returns the first interface in the given path matching the config.
+func ScoutInterface(path string, config InterfaceConfig) (*InterfaceNode, error) {
+	return ScoutInterfaceWithContext(context.Background(), path, config)
+}
+
+// ScoutInterfaceWithContext is ScoutInterface with a context.
+func ScoutInterfaceWithContext(ctx context.Context, path string, config InterfaceConfig) (*InterfaceNode, error) {
+	return getFirstOccurrence(ctx, interfaceScoutSetup{Path: path, Config: config}, "interface")
+}
+
+// ScoutInterfaces returns all interfaces in the given path matching the config.
+func ScoutInterfaces(path string, config InterfaceConfig) ([]*InterfaceNode, error) {
+	return ScoutInterfacesWithContext(context.Background(), path, config)
+}
+
+// ScoutInterfacesWithContext is ScoutInterfaces with a context.
+func ScoutInterfacesWithContext(ctx context.Context, path string, config InterfaceConfig) ([]*InterfaceNode, error) {
+	return getAllOccurrences(ctx, interfaceScoutSetup{Path: path, Config: config})
+}
+
+// StreamInterfaces returns the interfaces in the given path matching the config as a sequence, handed
+// out as they are found in the same way as StreamFunctions.
+func StreamInterfaces(path string, config InterfaceConfig) Seq2[*InterfaceNode, error] {
+	return StreamInterfacesWithContext(context.Background(), path, config)
+}
+
+// StreamInterfacesWithContext is StreamInterfaces with a context.
+func StreamInterfacesWithContext(ctx context.Context, path string, config InterfaceConfig) Seq2[*InterfaceNode, error] {
+	return streamOccurrences(ctx, interfaceScoutSetup{Path: path, Config: config})
+}
+
+// ScoutType returns the first declared type in the given path matching the config.
+func ScoutType(path string, config TypeConfig) (*TypeNode, error) {
+	return ScoutTypeWithContext(context.Background(), path, config)
+}
+
+// ScoutTypeWithContext is ScoutType with a context.
+func ScoutTypeWithContext(ctx context.Context, path string, config TypeConfig) (*TypeNode, error) {
+	return getFirstOccurrence(ctx, typeScoutSetup{Path: path, Config: config}, "type")
+}
+
+// ScoutTypes returns all declared types in the given path matching the config.
+func ScoutTypes(path string, config TypeConfig) ([]*TypeNode, error) {
+	return ScoutTypesWithContext(context.Background(), path, config)
+}
+
+// ScoutTypesWithContext is ScoutTypes with a context.
+func ScoutTypesWithContext(ctx context.Context, path string, config TypeConfig) ([]*TypeNode, error) {
+	return getAllOccurrences(ctx, typeScoutSetup{Path: path, Config: config})
+}
+
+// ScoutConst returns the first constant or enum group in the given path matching the config.
+func ScoutConst(path string, config ConstConfig) (*ConstNode, error) {
+	return ScoutConstWithContext(context.Background(), path, config)
+}
+
+// ScoutConstWithContext is ScoutConst with a context.
+func ScoutConstWithContext(ctx context.Context, path string, config ConstConfig) (*ConstNode, error) {
+	return getFirstOccurrence(ctx, constScoutSetup{Path: path, Config: config}, "constant")
+}
+
+// ScoutConsts returns all constants and enum groups in the given path matching the config.
+func ScoutConsts(path string, config ConstConfig) ([]*ConstNode, error) {
+	return ScoutConstsWithContext(context.Background(), path, config)
+}
+
+// ScoutConstsWithContext is ScoutConsts with a context.
+func ScoutConstsWithContext(ctx context.Context, path string, config ConstConfig) ([]*ConstNode, error) {
+	return getAllOccurrences(ctx, constScoutSetup{Path: path, Config: config})
+}
+
+// StreamConsts returns the constants and enum groups in the given path matching the config as a sequence, handed
+// out as they are found in the same way as StreamFunctions.
+func StreamConsts(path string, config ConstConfig) Seq2[*ConstNode, error] {
+	return StreamConstsWithContext(context.Background(), path, config)
+}
+
+// StreamConstsWithContext is StreamConsts with a context.
+func StreamConstsWithContext(ctx context.Context, path string, config ConstConfig) Seq2[*ConstNode, error] {
+	return streamOccurrences(ctx, constScoutSetup{Path: path, Config: config})
+}
+
+// ScoutVar returns the first variable in the given path matching the config.
+func ScoutVar(path string, config VarConfig) (*VarNode, error) {
+	return ScoutVarWithContext(context.Background(), path, config)
+}
+
+// ScoutVarWithContext is ScoutVar with a context.
+func ScoutVarWithContext(ctx context.Context, path string, config VarConfig) (*VarNode, error) {
+	return getFirstOccurrence(ctx, varScoutSetup{Path: path, Config: config}, "variable")
+}
+
+// ScoutVars returns all variables in the given path matching the config.
+func ScoutVars(path string, config VarConfig) ([]*VarNode, error) {
+	return ScoutVarsWithContext(context.Background(), path, config)
+}
+
+// ScoutVarsWithContext is ScoutVars with a context.
+func ScoutVarsWithContext(ctx context.Context, path string, config VarConfig) ([]*VarNode, error) {
+	return getAllOccurrences(ctx, varScoutSetup{Path: path, Config: config})
+}
+
+// StreamVars returns the variables in the given path matching the config as a sequence, handed
+// out as they are found in the same way as StreamFunctions.
+func StreamVars(path string, config VarConfig) Seq2[*VarNode, error] {
+	return StreamVarsWithContext(context.Background(), path, config)
+}
+
+// StreamVarsWithContext is StreamVars with a context.
+func StreamVarsWithContext(ctx context.Context, path string, config VarConfig) Seq2[*VarNode, error] {
+	return streamOccurrences(ctx, varScoutSetup{Path: path, Config: config})
+}
+
+// ScoutImplementation returns the first named type in the given path whose method set
+// satisfies the configured interface.
+func ScoutImplementation(path string, config ImplementsConfig) (*ImplementsNode, error) {
+	return ScoutImplementationWithContext(context.Background(), path, config)
+}
+
+// ScoutImplementationWithContext is ScoutImplementation with a context.
+func ScoutImplementationWithContext(ctx context.Context, path string, config ImplementsConfig) (*ImplementsNode, error) {
+	return getFirstOccurrence(ctx, implementsScoutSetup{Path: path, Config: config}, "implementation")
+}
+
+// ScoutImplementations returns all named types in the given path whose method set
+// satisfies the configured interface.
+func ScoutImplementations(path string, config ImplementsConfig) ([]*ImplementsNode, error) {
+	return ScoutImplementationsWithContext(context.Background(), path, config)
+}
+
+// ScoutImplementationsWithContext is ScoutImplementations with a context.
+func ScoutImplementationsWithContext(ctx context.Context, path string, config ImplementsConfig) ([]*ImplementsNode, error) {
+	return getAllOccurrences(ctx, implementsScoutSetup{Path: path, Config: config})
+}
+
+// ScoutCallGraph type-checks the packages at path and returns the static call graph of
+// every function and method declared in them.
+func ScoutCallGraph(path string, config CallGraphConfig) (*CallGraph, error) {
+	return ScoutCallGraphWithContext(context.Background(), path, config)
+}
+
+// ScoutCallGraphWithContext is ScoutCallGraph with a context.
+func ScoutCallGraphWithContext(ctx context.Context, path string, config CallGraphConfig) (*CallGraph, error) {
+	edges, err := getAllOccurrences(ctx, callGraphScoutSetup{Path: path, Config: config})
+	if err != nil && !isPartialResult(err) {
+		return nil, err
+	}
+	graph := CallGraph{Edges: make([]CallEdge, 0, len(edges))}
+	for _, edge := range edges {
+		graph.Edges = append(graph.Edges, *edge)
+	}
+	return &graph, err
+}
+
+// Query returns the declarations in the code at path matching a query expression, such as
+// `func(name =~ "^New", returns has error) or method(receiver = *Server and not calls Close)`.
+// Matches of every kind are returned in file and position order.
+func Query(path string, expr string) ([]*QueryMatch, error) {
+	return QueryWithContext(context.Background(), path, expr)
+}
+
+// QueryWithContext is Query with a context.
+func QueryWithContext(ctx context.Context, path string, expr string) ([]*QueryMatch, error) {
+	parsed, err := parseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	kinds := make(map[string]bool)
+	matcher, err := compileQuery(parsed, kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := queryCandidates(ctx, path, kinds)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]*QueryMatch, 0)
+	for _, candidate := range candidates {
+		if matcher(candidate) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches, nil
+}
+
+// References returns every use of the function, method, struct, struct field or constant in
+// the code at path, which is typically the directory tree containing the node. Uses are
+// resolved with go/types and fall back to matching by name where they cannot be resolved.
+func References(path string, node Referable, config ReferencesConfig) ([]*Reference, error) {
+	return ReferencesWithContext(context.Background(), path, node, config)
+}
+
+// ReferencesWithContext is References with a context.
+func ReferencesWithContext(ctx context.Context, path string, node Referable, config ReferencesConfig) ([]*Reference, error) {
+	return getAllOccurrences(ctx, referencesScoutSetup{Path: path, Target: node.Symbol(), Config: config})
+}
+
+// ScoutFunctionSource returns the first function in the in-memory Go source matching the config.
+// The src may be a string, []byte or io.Reader and name is used as the file path of the results.
+func ScoutFunctionSource(name string, src any, config FuncConfig) (*FuncNode, error) {
+	return ScoutFunctionSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutFunctionSourceWithContext is ScoutFunctionSource with a context.
+func ScoutFunctionSourceWithContext(ctx context.Context, name string, src any, config FuncConfig) (*FuncNode, error) {
+	return getFirstOccurrence(ctx, funcScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config}, "function")
+}
+
+// ScoutFunctionsSource returns all functions in the in-memory Go source matching the config.
+func ScoutFunctionsSource(name string, src any, config FuncConfig) ([]*FuncNode, error) {
+	return ScoutFunctionsSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutFunctionsSourceWithContext is ScoutFunctionsSource with a context.
+func ScoutFunctionsSourceWithContext(ctx context.Context, name string, src any, config FuncConfig) ([]*FuncNode, error) {
+	return getAllOccurrences(ctx, funcScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config})
+}
+
+// ScoutStructSource returns the first struct in the in-memory Go source matching the config.
+func ScoutStructSource(name string, src any, config StructConfig) (*StructNode, error) {
+	return ScoutStructSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutStructSourceWithContext is ScoutStructSource with a context.
+func ScoutStructSourceWithContext(ctx context.Context, name string, src any, config StructConfig) (*StructNode, error) {
+	return getFirstOccurrence(ctx, structScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config}, "struct")
+}
+
+// ScoutStructsSource returns all structs in the in-memory Go source matching the config.
+func ScoutStructsSource(name string, src any, config StructConfig) ([]*StructNode, error) {
+	return ScoutStructsSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutStructsSourceWithContext is ScoutStructsSource with a context.
+func ScoutStructsSourceWithContext(ctx context.Context, name string, src any, config StructConfig) ([]*StructNode, error) {
+	return getAllOccurrences(ctx, structScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config})
+}
+
+// ScoutMethodSource returns the first method in the in-memory Go source matching the config.
+func ScoutMethodSource(name string, src any, config MethodConfig) (*MethodNode, error) {
+	return ScoutMethodSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutMethodSourceWithContext is ScoutMethodSource with a context.
+func ScoutMethodSourceWithContext(ctx context.Context, name string, src any, config MethodConfig) (*MethodNode, error) {
+	return getFirstOccurrence(ctx, methodScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config}, "method")
+}
+
+// ScoutMethodsSource returns all methods in the in-memory Go source matching the config.
+func ScoutMethodsSource(name string, src any, config MethodConfig) ([]*MethodNode, error) {
+	return ScoutMethodsSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutMethodsSourceWithContext is ScoutMethodsSource with a context.
+func ScoutMethodsSourceWithContext(ctx context.Context, name string, src any, config MethodConfig) ([]*MethodNode, error) {
+	return getAllOccurrences(ctx, methodScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config})
+}
+
+// ScoutInterfaceSource returns the first interface in the in-memory Go source matching the config.
+func ScoutInterfaceSource(name string, src any, config InterfaceConfig) (*InterfaceNode, error) {
+	return ScoutInterfaceSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutInterfaceSourceWithContext is ScoutInterfaceSource with a context.
+func ScoutInterfaceSourceWithContext(ctx context.Context, name string, src any, config InterfaceConfig) (*InterfaceNode, error) {
+	return getFirstOccurrence(ctx, interfaceScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config}, "interface")
+}
+
+// ScoutInterfacesSource returns all interfaces in the in-memory Go source matching the config.
+func ScoutInterfacesSource(name string, src any, config InterfaceConfig) ([]*InterfaceNode, error) {
+	return ScoutInterfacesSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutInterfacesSourceWithContext is ScoutInterfacesSource with a context.
+func ScoutInterfacesSourceWithContext(ctx context.Context, name string, src any, config InterfaceConfig) ([]*InterfaceNode, error) {
+	return getAllOccurrences(ctx, interfaceScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config})
+}
+
+// ScoutTypeSource returns the first declared type in the in-memory Go source matching the config.
+func ScoutTypeSource(name string, src any, config TypeConfig) (*TypeNode, error) {
+	return ScoutTypeSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutTypeSourceWithContext is ScoutTypeSource with a context.
+func ScoutTypeSourceWithContext(ctx context.Context, name string, src any, config TypeConfig) (*TypeNode, error) {
+	return getFirstOccurrence(ctx, typeScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config}, "type")
+}
+
+// ScoutTypesSource returns all declared types in the in-memory Go source matching the config.
+func ScoutTypesSource(name string, src any, config TypeConfig) ([]*TypeNode, error) {
+	return ScoutTypesSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutTypesSourceWithContext is ScoutTypesSource with a context.
+func ScoutTypesSourceWithContext(ctx context.Context, name string, src any, config TypeConfig) ([]*TypeNode, error) {
+	return getAllOccurrences(ctx, typeScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config})
+}
+
+// ScoutConstSource returns the first constant or enum group in the in-memory Go source matching the config.
+func ScoutConstSource(name string, src any, config ConstConfig) (*ConstNode, error) {
+	return ScoutConstSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutConstSourceWithContext is ScoutConstSource with a context.
+func ScoutConstSourceWithContext(ctx context.Context, name string, src any, config ConstConfig) (*ConstNode, error) {
+	return getFirstOccurrence(ctx, constScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config}, "constant")
+}
+
+// ScoutConstsSource returns all constants and enum groups in the in-memory Go source matching the config.
+func ScoutConstsSource(name string, src any, config ConstConfig) ([]*ConstNode, error) {
+	return ScoutConstsSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutConstsSourceWithContext is ScoutConstsSource with a context.
+func ScoutConstsSourceWithContext(ctx context.Context, name string, src any, config ConstConfig) ([]*ConstNode, error) {
+	return getAllOccurrences(ctx, constScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config})
+}
+
+// ScoutVarSource returns the first variable in the in-memory Go source matching the config.
+func ScoutVarSource(name string, src any, config VarConfig) (*VarNode, error) {
+	return ScoutVarSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutVarSourceWithContext is ScoutVarSource with a context.
+func ScoutVarSourceWithContext(ctx context.Context, name string, src any, config VarConfig) (*VarNode, error) {
+	return getFirstOccurrence(ctx, varScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config}, "variable")
+}
+
+// ScoutVarsSource returns all variables in the in-memory Go source matching the config.
+func ScoutVarsSource(name string, src any, config VarConfig) ([]*VarNode, error) {
+	return ScoutVarsSourceWithContext(context.Background(), name, src, config)
+}
+
+// ScoutVarsSourceWithContext is ScoutVarsSource with a context.
+func ScoutVarsSourceWithContext(ctx context.Context, name string, src any, config VarConfig) ([]*VarNode, error) {
+	return getAllOccurrences(ctx, varScoutSetup{Path: name, Source: memorySource{Name: name, Src: src}, Config: config})
+}
+
+// ScoutFunctionFS returns the first function in the file system path matching the config.
+// The path may be a Go file, a directory or a recursive "dir/..." pattern within fsys.
+func ScoutFunctionFS(fsys fs.FS, path string, config FuncConfig) (*FuncNode, error) {
+	return ScoutFunctionFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutFunctionFSWithContext is ScoutFunctionFS with a context.
+func ScoutFunctionFSWithContext(ctx context.Context, fsys fs.FS, path string, config FuncConfig) (*FuncNode, error) {
+	return getFirstOccurrence(ctx, funcScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config}, "function")
+}
+
+// ScoutFunctionsFS returns all functions in the file system path matching the config.
+func ScoutFunctionsFS(fsys fs.FS, path string, config FuncConfig) ([]*FuncNode, error) {
+	return ScoutFunctionsFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutFunctionsFSWithContext is ScoutFunctionsFS with a context.
+func ScoutFunctionsFSWithContext(ctx context.Context, fsys fs.FS, path string, config FuncConfig) ([]*FuncNode, error) {
+	return getAllOccurrences(ctx, funcScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config})
+}
+
+// ScoutStructFS returns the first struct in the file system path matching the config.
+func ScoutStructFS(fsys fs.FS, path string, config StructConfig) (*StructNode, error) {
+	return ScoutStructFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutStructFSWithContext is ScoutStructFS with a context.
+func ScoutStructFSWithContext(ctx context.Context, fsys fs.FS, path string, config StructConfig) (*StructNode, error) {
+	return getFirstOccurrence(ctx, structScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config}, "struct")
+}
+
+// ScoutStructsFS returns all structs in the file system path matching the config.
+func ScoutStructsFS(fsys fs.FS, path string, config StructConfig) ([]*StructNode, error) {
+	return ScoutStructsFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutStructsFSWithContext is ScoutStructsFS with a context.
+func ScoutStructsFSWithContext(ctx context.Context, fsys fs.FS, path string, config StructConfig) ([]*StructNode, error) {
+	return getAllOccurrences(ctx, structScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config})
+}
+
+// ScoutMethodFS returns the first method in the file system path matching the config.
+func ScoutMethodFS(fsys fs.FS, path string, config MethodConfig) (*MethodNode, error) {
+	return ScoutMethodFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutMethodFSWithContext is ScoutMethodFS with a context.
+func ScoutMethodFSWithContext(ctx context.Context, fsys fs.FS, path string, config MethodConfig) (*MethodNode, error) {
+	return getFirstOccurrence(ctx, methodScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config}, "method")
+}
+
+// ScoutMethodsFS returns all methods in the file system path matching the config.
+func ScoutMethodsFS(fsys fs.FS, path string, config MethodConfig) ([]*MethodNode, error) {
+	return ScoutMethodsFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutMethodsFSWithContext is ScoutMethodsFS with a context.
+func ScoutMethodsFSWithContext(ctx context.Context, fsys fs.FS, path string, config MethodConfig) ([]*MethodNode, error) {
+	return getAllOccurrences(ctx, methodScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config})
+}
+
+// ScoutInterfaceFS returns the first interface in the file system path matching the config.
+func ScoutInterfaceFS(fsys fs.FS, path string, config InterfaceConfig) (*InterfaceNode, error) {
+	return ScoutInterfaceFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutInterfaceFSWithContext is ScoutInterfaceFS with a context.
+func ScoutInterfaceFSWithContext(ctx context.Context, fsys fs.FS, path string, config InterfaceConfig) (*InterfaceNode, error) {
+	return getFirstOccurrence(ctx, interfaceScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config}, "interface")
+}
+
+// ScoutInterfacesFS returns all interfaces in the file system path matching the config.
+func ScoutInterfacesFS(fsys fs.FS, path string, config InterfaceConfig) ([]*InterfaceNode, error) {
+	return ScoutInterfacesFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutInterfacesFSWithContext is ScoutInterfacesFS with a context.
+func ScoutInterfacesFSWithContext(ctx context.Context, fsys fs.FS, path string, config InterfaceConfig) ([]*InterfaceNode, error) {
+	return getAllOccurrences(ctx, interfaceScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config})
+}
+
+// ScoutConstFS returns the first constant or enum group in the file system path matching the config.
+func ScoutConstFS(fsys fs.FS, path string, config ConstConfig) (*ConstNode, error) {
+	return ScoutConstFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutConstFSWithContext is ScoutConstFS with a context.
+func ScoutConstFSWithContext(ctx context.Context, fsys fs.FS, path string, config ConstConfig) (*ConstNode, error) {
+	return getFirstOccurrence(ctx, constScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config}, "constant")
+}
+
+// ScoutConstsFS returns all constants and enum groups in the file system path matching the config.
+func ScoutConstsFS(fsys fs.FS, path string, config ConstConfig) ([]*ConstNode, error) {
+	return ScoutConstsFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutConstsFSWithContext is ScoutConstsFS with a context.
+func ScoutConstsFSWithContext(ctx context.Context, fsys fs.FS, path string, config ConstConfig) ([]*ConstNode, error) {
+	return getAllOccurrences(ctx, constScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config})
+}
+
+// ScoutVarFS returns the first variable in the file system path matching the config.
+func ScoutVarFS(fsys fs.FS, path string, config VarConfig) (*VarNode, error) {
+	return ScoutVarFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutVarFSWithContext is ScoutVarFS with a context.
+func ScoutVarFSWithContext(ctx context.Context, fsys fs.FS, path string, config VarConfig) (*VarNode, error) {
+	return getFirstOccurrence(ctx, varScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config}, "variable")
+}
+
+// ScoutVarsFS returns all variables in the file system path matching the config.
+func ScoutVarsFS(fsys fs.FS, path string, config VarConfig) ([]*VarNode, error) {
+	return ScoutVarsFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutVarsFSWithContext is ScoutVarsFS with a context.
+func ScoutVarsFSWithContext(ctx context.Context, fsys fs.FS, path string, config VarConfig) ([]*VarNode, error) {
+	return getAllOccurrences(ctx, varScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config})
+}
+
+// ScoutTypeFS returns the first declared type in the file system path matching the config.
+func ScoutTypeFS(fsys fs.FS, path string, config TypeConfig) (*TypeNode, error) {
+	return ScoutTypeFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutTypeFSWithContext is ScoutTypeFS with a context.
+func ScoutTypeFSWithContext(ctx context.Context, fsys fs.FS, path string, config TypeConfig) (*TypeNode, error) {
+	return getFirstOccurrence(ctx, typeScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config}, "type")
+}
+
+// ScoutTypesFS returns all declared types in the file system path matching the config.
+func ScoutTypesFS(fsys fs.FS, path string, config TypeConfig) ([]*TypeNode, error) {
+	return ScoutTypesFSWithContext(context.Background(), fsys, path, config)
+}
+
+// ScoutTypesFSWithContext is ScoutTypesFS with a context.
+func ScoutTypesFSWithContext(ctx context.Context, fsys fs.FS, path string, config TypeConfig) ([]*TypeNode, error) {
+	return getAllOccurrences(ctx, typeScoutSetup{Path: path, Source: fsSource{FS: fsys, Path: path}, Config: config})
 }
@@ -1,12 +1,21 @@
 package codescout
 
 import (
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/assert"
 )
 
+// withoutRanges clears the ranges of a node, which are covered by TestNodeRanges.
+func withoutRanges(node BaseNode) BaseNode {
+	node.Range, node.DocRange, node.SignatureRange, node.BodyRange = Range{}, Range{}, Range{}, Range{}
+	return node
+}
+
 func TestScoutFunction(t *testing.T) {
 	path := filepath.Join("testdata", "scout_single.go")
 	type FuncTestCase struct {
@@ -57,7 +66,7 @@ func TestScoutFunction(t *testing.T) {
 		t.Run(tt.Name, func(t *testing.T) {
 			funcNode, err := ScoutFunction(path, tt.Config)
 			assert.NoError(t, err)
-			assert.Equal(t, tt.Expected, funcNode.Node)
+			assert.Equal(t, tt.Expected, withoutRanges(funcNode.Node))
 		})
 	}
 }
@@ -101,7 +110,7 @@ func TestScoutMethod(t *testing.T) {
 		t.Run(tt.Name, func(t *testing.T) {
 			mehodNode, err := ScoutMethod(path, tt.Config)
 			assert.NoError(t, err)
-			assert.Equal(t, tt.Expected, mehodNode.Node)
+			assert.Equal(t, tt.Expected, withoutRanges(mehodNode.Node))
 		})
 	}
 }
@@ -147,7 +156,674 @@ func TestScoutStruct(t *testing.T) {
 		t.Run(tt.Name, func(t *testing.T) {
 			structNode, err := ScoutStruct(path, tt.Config)
 			assert.NoError(t, err)
-			assert.Equal(t, tt.Expected, structNode.Node)
+			assert.Equal(t, tt.Expected, withoutRanges(structNode.Node))
 		})
 	}
 }
+
+func TestScoutNamePatterns(t *testing.T) {
+	path := filepath.Join("testdata", "scout_single.go")
+
+	funcNodes, err := ScoutFunctions(path, FuncConfig{NamePattern: "*t*"})
+	assert.NoError(t, err)
+	names := make([]string, 0, len(funcNodes))
+	for _, funcNode := range funcNodes {
+		names = append(names, funcNode.Name())
+	}
+	assert.Equal(t, []string{"Greet", "Factorial"}, names)
+
+	funcNode, err := ScoutFunction(path, FuncConfig{NamePattern: "^F[a-z]+l$", PatternMode: RegexPattern})
+	assert.NoError(t, err)
+	assert.Equal(t, "Factorial", funcNode.Name())
+
+	methodNode, err := ScoutMethod(path, MethodConfig{ReceiverPattern: "*C*"})
+	assert.NoError(t, err)
+	assert.Equal(t, "DisplayDetails", methodNode.Name())
+
+	structNodes, err := ScoutStructs(path, StructConfig{NamePattern: "^(Person|Car)$", PatternMode: RegexPattern})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 2)
+
+	_, err = ScoutFunction(path, FuncConfig{NamePattern: "(", PatternMode: RegexPattern})
+	assert.Error(t, err)
+
+	_, err = ScoutFunction(path, FuncConfig{NamePattern: "G*", PatternMode: "fuzzy"})
+	assert.Error(t, err)
+}
+
+func TestScoutTypeMatch(t *testing.T) {
+	path := filepath.Join("testdata", "scout_typed", "typed.go")
+
+	_, err := ScoutFunction(path, FuncConfig{ReturnTypes: []string{"[]byte", "builtin.error"}})
+	assert.Error(t, err)
+
+	funcNode, err := ScoutFunction(path, FuncConfig{ReturnTypes: []string{"[]byte", "builtin.error"}, TypeMatch: IdenticalTypes})
+	assert.NoError(t, err)
+	assert.Equal(t, "Decode", funcNode.Name())
+
+	funcNode, err = ScoutFunction(path, FuncConfig{
+		ParamTypes: []NamedType{{Name: "ctx", Type: "context.Context"}}, TypeMatch: IdenticalTypes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Decode", funcNode.Name())
+
+	funcNode, err = ScoutFunction(path, FuncConfig{ParamTypes: []NamedType{{Type: "[]uint8"}}, TypeMatch: IdenticalTypes})
+	assert.NoError(t, err)
+	assert.Equal(t, "Encode", funcNode.Name())
+
+	funcNode, err = ScoutFunction(path, FuncConfig{ParamTypes: []NamedType{{Type: "...int"}}, TypeMatch: IdenticalTypes})
+	assert.NoError(t, err)
+	assert.Equal(t, "Sum", funcNode.Name())
+
+	funcNodes, err := ScoutFunctions(path, FuncConfig{ReturnTypes: []string{"io.Reader"}, TypeMatch: AssignableTypes})
+	assert.NoError(t, err)
+	assert.Len(t, funcNodes, 1)
+	assert.Equal(t, "Encode", funcNodes[0].Name())
+
+	methodNode, err := ScoutMethod(path, MethodConfig{
+		ParamTypes: []NamedType{{Type: "[]byte"}}, ReturnTypes: []string{"int", "error"}, Exact: true, TypeMatch: IdenticalTypes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Write", methodNode.Name())
+
+	structNode, err := ScoutStruct(path, StructConfig{
+		FieldTypes: []NamedType{{Name: "Data", Type: "[]byte"}}, TypeMatch: IdenticalTypes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Buffer", structNode.Name())
+
+	_, err = ScoutFunction(path, FuncConfig{ReturnTypes: []string{"nosuch.Type"}, TypeMatch: IdenticalTypes})
+	assert.Error(t, err)
+
+	_, err = ScoutFunction(path, FuncConfig{TypeMatch: "fuzzy"})
+	assert.Error(t, err)
+}
+
+func TestScoutStructTags(t *testing.T) {
+	path := filepath.Join("testdata", "scout_tags.go")
+
+	structNode, err := ScoutStruct(path, StructConfig{Tags: []TagFilter{{Key: "json", Value: "-"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "User", structNode.Name())
+	fields := structNode.Fields()
+	assert.Equal(t, `json:"email" db:"email" validate:"required,email"`, fields[1].Tag)
+	assert.Equal(t, "required,email", fields[1].StructTag().Get("validate"))
+
+	structNodes, err := ScoutStructs(path, StructConfig{Tags: []TagFilter{{Key: "json"}, {Key: "db", Missing: true}}})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 1)
+	assert.Equal(t, "Session", structNodes[0].Name())
+
+	structNodes, err = ScoutStructs(path, StructConfig{Tags: []TagFilter{{Key: "validate", Field: "ID"}}})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 0)
+
+	structNodes, err = ScoutStructs(path, StructConfig{Tags: []TagFilter{{Key: "json", Missing: true}}})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 1)
+	assert.Equal(t, "Options", structNodes[0].Name())
+
+	_, err = ScoutStructs(path, StructConfig{Tags: []TagFilter{{Value: "-"}}})
+	assert.Error(t, err)
+}
+
+func TestScoutStructEmbeds(t *testing.T) {
+	path := filepath.Join("testdata", "scout_embeds.go")
+
+	structNode, err := ScoutStruct(path, StructConfig{Name: "Document"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"*Audit", "Owner", "sync.Mutex"}, structNode.Embeds())
+	assert.Equal(t, NamedType{Name: "Mutex", Type: "sync.Mutex", Embedded: true}, structNode.Fields()[2])
+	assert.Equal(t, []NamedType{
+		{Name: "Entity", Type: "Entity", Embedded: true},
+		{Name: "Version", Type: "int"},
+	}, structNode.PromotedFields())
+	assert.Equal(t, []string{"Touch"}, structNode.PromotedMethods())
+
+	structNodes, err := ScoutStructs(path, StructConfig{Embeds: []string{"Audit"}})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 1)
+	assert.Equal(t, "Document", structNodes[0].Name())
+
+	structNode, err = ScoutStruct(path, StructConfig{FieldTypes: []NamedType{{Name: "Entity"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Audit", structNode.Name())
+
+	noEmbeds := true
+	structNodes, err = ScoutStructs(path, StructConfig{NoEmbeds: &noEmbeds})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 3)
+
+	structNodes, err = ScoutStructs(path, StructConfig{ExcludeEmbeds: []string{"sync.Mutex", "Entity"}})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 3)
+}
+
+func TestScoutGenerics(t *testing.T) {
+	path := filepath.Join("testdata", "scout_generics.go")
+
+	funcNode, err := ScoutFunction(path, FuncConfig{TypeParams: []NamedType{{Type: "comparable"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Index", funcNode.Name())
+	assert.Equal(t, []NamedType{{Name: "T", Type: "comparable"}}, funcNode.CallableOps.TypeParams())
+
+	funcNode, err = ScoutFunction(path, FuncConfig{TypeParams: []NamedType{{Name: "S", Type: "~[]E"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Map", funcNode.Name())
+	assert.Equal(t, []NamedType{{Name: "S", Type: "~[]E"}, {Name: "E", Type: "any"}, {Name: "R", Type: "any"}},
+		funcNode.CallableOps.TypeParams())
+
+	isGeneric := false
+	funcNodes, err := ScoutFunctions(path, FuncConfig{IsGeneric: &isGeneric})
+	assert.NoError(t, err)
+	assert.Len(t, funcNodes, 1)
+	assert.Equal(t, "Sum", funcNodes[0].Name())
+
+	structNode, err := ScoutStruct(path, StructConfig{TypeParams: []NamedType{{Name: "K", Type: "comparable"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Cache", structNode.Name())
+	assert.Equal(t, "Cache[K comparable, V any]", structNode.Signature())
+	assert.Len(t, structNode.Methods, 1)
+
+	structNode, err = ScoutStruct(path, StructConfig{Name: "Stack"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Stack[T any]", structNode.Signature())
+	assert.Len(t, structNode.Methods, 2)
+
+	noTypeParams := true
+	structNodes, err := ScoutStructs(path, StructConfig{NoTypeParams: &noTypeParams})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 1)
+	assert.Equal(t, "Counter", structNodes[0].Name())
+
+	methodNode, err := ScoutMethod(path, MethodConfig{Name: "Get"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Cache", methodNode.ReceiverType())
+	assert.Equal(t, []string{"K", "V"}, methodNode.ReceiverTypeParams())
+	assert.Equal(t, []NamedType{{Name: "K", Type: "comparable"}, {Name: "V", Type: "any"}}, methodNode.TypeParams())
+	assert.Equal(t, methodNode.TypeParams(), methodNode.ToRecord().TypeParams)
+
+	methodNode, err = ScoutMethod(path, MethodConfig{TypeParams: []NamedType{{Name: "E", Type: "any"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Len", methodNode.Name())
+
+	isGeneric = true
+	methodNodes, err := ScoutMethods(path, MethodConfig{IsGeneric: &isGeneric})
+	assert.NoError(t, err)
+	assert.Len(t, methodNodes, 3)
+
+	methodNodes, err = ScoutMethods(path, MethodConfig{NoTypeParams: &noTypeParams})
+	assert.NoError(t, err)
+	assert.Len(t, methodNodes, 1)
+	assert.Equal(t, "Incr", methodNodes[0].Name())
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"a.go": "package set\n\nfunc (s Set[E]) Has(item E) bool { _, ok := s[item]; return ok }\n",
+		"b.go": "package set\n\ntype Set[T comparable] map[T]struct{}\n",
+	} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644))
+	}
+	methodNode, err = ScoutMethod(filepath.Join(dir, "a.go"), MethodConfig{Name: "Has"})
+	assert.NoError(t, err)
+	assert.Equal(t, []NamedType{{Name: "E", Type: "comparable"}}, methodNode.TypeParams())
+
+	methodNode, err = ScoutMethod(path, MethodConfig{Receiver: "Stack", Name: "Push"})
+	assert.NoError(t, err)
+	assert.True(t, methodNode.HasPointerReceiver())
+}
+
+func TestScoutExclusions(t *testing.T) {
+	typedPath := filepath.Join("testdata", "scout_typed")
+
+	funcNodes, err := ScoutFunctions(typedPath, FuncConfig{ExcludeReturnTypes: []string{"error"}})
+	assert.NoError(t, err)
+	assert.Len(t, funcNodes, 2)
+	assert.Equal(t, "Encode", funcNodes[0].Name())
+	assert.Equal(t, "Sum", funcNodes[1].Name())
+
+	funcNodes, err = ScoutFunctions(typedPath, FuncConfig{
+		ExcludeParamTypes: []NamedType{{Type: "context.Context"}}, TypeMatch: IdenticalTypes,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, funcNodes, 2)
+	assert.Equal(t, "Encode", funcNodes[0].Name())
+
+	noParams := false
+	funcNodes, err = ScoutFunctions(typedPath, FuncConfig{ExcludeParamTypes: []NamedType{{Name: "ctx"}}, NoParams: &noParams})
+	assert.NoError(t, err)
+	assert.Len(t, funcNodes, 2)
+
+	funcNodes, err = ScoutFunctions(typedPath, FuncConfig{
+		ReturnTypes: []string{"error"}, ExcludeReturnTypes: []string{"error"},
+	})
+	assert.EqualError(t, err, "error cannot be in both ReturnTypes and ExcludeReturnTypes")
+	assert.Nil(t, funcNodes)
+
+	noReturn := true
+	_, err = ScoutFunctions(typedPath, FuncConfig{ExcludeReturnTypes: []string{"error"}, NoReturn: &noReturn})
+	assert.EqualError(t, err, "ExcludeReturnTypes cannot be specified if NoReturn is set to true")
+
+	singlePath := filepath.Join("testdata", "scout_single.go")
+	methodNodes, err := ScoutMethods(singlePath, MethodConfig{ExcludeFields: []string{"Year"}})
+	assert.NoError(t, err)
+	assert.Len(t, methodNodes, 1)
+	assert.Equal(t, "Birthday", methodNodes[0].Name())
+
+	methodNodes, err = ScoutMethods(singlePath, MethodConfig{Fields: []string{"Make"}, ExcludeFields: []string{"Age"}})
+	assert.NoError(t, err)
+	assert.Len(t, methodNodes, 1)
+	assert.Equal(t, "DisplayDetails", methodNodes[0].Name())
+
+	genericsPath := filepath.Join("testdata", "scout_generics.go")
+	structNodes, err := ScoutStructs(genericsPath, StructConfig{ExcludeMethods: []string{"Get", "Incr"}})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 1)
+	assert.Equal(t, "Stack", structNodes[0].Name())
+
+	structNodes, err = ScoutStructs(singlePath, StructConfig{ExcludeFieldTypes: []NamedType{{Name: "Age"}}})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 1)
+	assert.Equal(t, "Car", structNodes[0].Name())
+}
+
+func TestScoutOrderedParams(t *testing.T) {
+	src := `package repo
+
+import "context"
+
+type Repo struct{}
+
+func (r *Repo) Get(ctx context.Context, id int) error { return nil }
+
+func (r *Repo) Count(id int, ctx context.Context) int { return 0 }
+
+func (r *Repo) Close(ctx context.Context) error { return nil }
+`
+	ctxParam := []NamedType{{Name: "ctx", Type: "context.Context"}}
+	methodNodes, err := ScoutMethodsSource("repo.go", src, MethodConfig{ParamTypes: ctxParam})
+	assert.NoError(t, err)
+	assert.Len(t, methodNodes, 3)
+
+	for _, typeMatch := range []TypeMatchMode{TextualTypes, IdenticalTypes} {
+		methodNodes, err = ScoutMethodsSource("repo.go", src, MethodConfig{ParamTypes: ctxParam, OrderedParams: true, TypeMatch: typeMatch})
+		assert.NoError(t, err)
+		assert.Len(t, methodNodes, 2)
+		assert.Equal(t, "Get", methodNodes[0].Name())
+		assert.Equal(t, "Close", methodNodes[1].Name())
+
+		methodNodes, err = ScoutMethodsSource("repo.go", src, MethodConfig{
+			ParamTypes: []NamedType{{Type: "context.Context"}, {Name: "id"}}, OrderedParams: true, TypeMatch: typeMatch,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, methodNodes, 1)
+		assert.Equal(t, "Get", methodNodes[0].Name())
+
+		methodNodes, err = ScoutMethodsSource("repo.go", src, MethodConfig{ParamTypes: ctxParam, OrderedParams: true, Exact: true, TypeMatch: typeMatch})
+		assert.NoError(t, err)
+		assert.Len(t, methodNodes, 1)
+		assert.Equal(t, "Close", methodNodes[0].Name())
+	}
+
+	funcNodes, err := ScoutFunctionsSource("repo.go", src+"\nfunc Load(id int, ctx context.Context) {}\n", FuncConfig{ParamTypes: ctxParam, OrderedParams: true})
+	assert.NoError(t, err)
+	assert.Empty(t, funcNodes)
+}
+
+func TestScoutDirectory(t *testing.T) {
+	dir := filepath.Join("testdata", "scout_dir")
+	funcNodes, err := ScoutFunctions(dir, FuncConfig{ReturnTypes: []string{"error"}})
+	assert.NoError(t, err)
+	assert.Len(t, funcNodes, 2)
+	assert.Equal(t, "Describe", funcNodes[0].Node.Name)
+	assert.Equal(t, filepath.Join(dir, "area.go"), funcNodes[0].Node.Path)
+	assert.Equal(t, "NewRect", funcNodes[1].Node.Name)
+	assert.Equal(t, filepath.Join(dir, "shapes.go"), funcNodes[1].Node.Path)
+
+	structNode, err := ScoutStruct(dir, StructConfig{Name: "Rect"})
+	assert.NoError(t, err)
+	assert.Len(t, structNode.Methods, 1)
+	assert.Equal(t, filepath.Join(dir, "area.go"), structNode.Methods[0].Node.Path)
+}
+
+func TestScoutSortOrder(t *testing.T) {
+	fsys := fstest.MapFS{
+		"pkg/b.go": {Data: []byte("package pkg\n\ntype Zone struct{}\n\ntype config struct{}\n\nfunc (z Zone) Name() string { return \"\" }\n")},
+		"pkg/a.go": {Data: []byte("package pkg\n\ntype beta struct{}\n\ntype Zone struct{ ID int }\n\ntype Alpha struct{}\n")},
+	}
+	names := func(nodes []*StructNode) []string {
+		result := make([]string, 0, len(nodes))
+		for _, node := range nodes {
+			result = append(result, node.Node.Path+":"+node.Name())
+		}
+		return result
+	}
+
+	structNodes, err := ScoutStructsFS(fsys, "pkg", StructConfig{})
+	assert.NoError(t, err)
+	expected := []string{"pkg/a.go:beta", "pkg/a.go:Zone", "pkg/a.go:Alpha", "pkg/b.go:Zone", "pkg/b.go:config"}
+	assert.Equal(t, expected, names(structNodes))
+	assert.Len(t, structNodes[1].Methods, 1)
+	assert.Len(t, structNodes[3].Methods, 1)
+
+	structNodes, err = ScoutStructsFS(fsys, "pkg", StructConfig{Sort: NameSort})
+	assert.NoError(t, err)
+	expected = []string{"pkg/a.go:Alpha", "pkg/a.go:Zone", "pkg/b.go:Zone", "pkg/a.go:beta", "pkg/b.go:config"}
+	assert.Equal(t, expected, names(structNodes))
+
+	structNodes, err = ScoutStructsFS(fsys, "pkg", StructConfig{Sort: ExportedSort})
+	assert.NoError(t, err)
+	expected = []string{"pkg/a.go:Zone", "pkg/a.go:Alpha", "pkg/b.go:Zone", "pkg/a.go:beta", "pkg/b.go:config"}
+	assert.Equal(t, expected, names(structNodes))
+
+	_, err = ScoutStructsFS(fsys, "pkg", StructConfig{Sort: "size"})
+	assert.Error(t, err)
+}
+
+func TestScoutRecursivePattern(t *testing.T) {
+	pattern := filepath.ToSlash(filepath.Join("testdata", "scout_dir")) + "/..."
+	methodNodes, err := ScoutMethods(pattern, MethodConfig{})
+	assert.NoError(t, err)
+	assert.Len(t, methodNodes, 2)
+	assert.Equal(t, "Area", methodNodes[0].Node.Name)
+	assert.Equal(t, "Validate", methodNodes[1].Node.Name)
+	assert.Equal(t, filepath.Join("testdata", "scout_dir", "nested", "circle.go"), methodNodes[1].Node.Path)
+
+	_, err = ScoutFunctions(filepath.Join("testdata", "missing")+"/...", FuncConfig{})
+	assert.Error(t, err)
+}
+
+func writeBrokenFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "broken.go")
+	src := `package broken
+
+func Valid() error { return nil }
+
+func Broken() {
+	if {
+	}
+}
+
+func AlsoValid() string { return "" }
+`
+	assert.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+	return path
+}
+
+func TestScoutParseError(t *testing.T) {
+	path := writeBrokenFile(t)
+
+	_, err := ScoutFunctions(path, FuncConfig{})
+	var parseErr *ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, path, parseErr.File)
+	assert.Equal(t, 6, parseErr.Line)
+	assert.Greater(t, parseErr.Column, 0)
+	assert.NotEmpty(t, parseErr.Msg)
+}
+
+func TestScoutPartial(t *testing.T) {
+	path := writeBrokenFile(t)
+
+	funcNodes, err := ScoutFunctions(path, FuncConfig{Partial: true})
+	var parseErrs ParseErrors
+	assert.ErrorAs(t, err, &parseErrs)
+	assert.NotEmpty(t, parseErrs)
+	names := make([]string, 0, len(funcNodes))
+	for _, funcNode := range funcNodes {
+		names = append(names, funcNode.Name())
+	}
+	assert.Contains(t, names, "Valid")
+	assert.Contains(t, names, "AlsoValid")
+
+	funcNode, err := ScoutFunction(path, FuncConfig{Name: "Valid", Partial: true})
+	assert.ErrorAs(t, err, &parseErrs)
+	assert.Equal(t, "Valid", funcNode.Name())
+}
+
+func TestScoutSource(t *testing.T) {
+	src := `package buffer
+
+// Open opens the buffer.
+func Open(name string) error { return nil }
+
+type Buffer struct {
+	data []byte
+}
+
+func (b *Buffer) Len() int { return len(b.data) }
+`
+	funcNode, err := ScoutFunctionSource("buffer.go", []byte(src), FuncConfig{ReturnTypes: []string{"error"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Open", funcNode.Name())
+	assert.Equal(t, "buffer.go", funcNode.Node.Path)
+	assert.Equal(t, 4, funcNode.Node.Line)
+
+	structNodes, err := ScoutStructsSource("buffer.go", strings.NewReader(src), StructConfig{})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 1)
+	assert.Len(t, structNodes[0].Methods, 1)
+
+	methodNode, err := ScoutMethodSource("buffer.go", src, MethodConfig{Receiver: "Buffer"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"data"}, methodNode.FieldsAccessed())
+}
+
+func TestScoutFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"pkg/a.go":     {Data: []byte("package pkg\n\nfunc A() error { return nil }\n")},
+		"pkg/sub/b.go": {Data: []byte("package sub\n\ntype B struct{}\n\nfunc (b B) Err() error { return nil }\n")},
+	}
+
+	funcNodes, err := ScoutFunctionsFS(fsys, "pkg/...", FuncConfig{})
+	assert.NoError(t, err)
+	assert.Len(t, funcNodes, 1)
+	assert.Equal(t, "pkg/a.go", funcNodes[0].Node.Path)
+
+	methodNode, err := ScoutMethodFS(fsys, "pkg/...", MethodConfig{ReturnTypes: []string{"error"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "pkg/sub/b.go", methodNode.Node.Path)
+
+	structNode, err := ScoutStructFS(fsys, "pkg/sub", StructConfig{Name: "B"})
+	assert.NoError(t, err)
+	assert.Len(t, structNode.Methods, 1)
+
+	_, err = ScoutStructsFS(fsys, "missing", StructConfig{})
+	assert.Error(t, err)
+}
+
+func TestScoutInterface(t *testing.T) {
+	path := filepath.Join("testdata", "scout_interfaces.go")
+	noMethods := true
+	tests := []struct {
+		Name     string
+		Config   InterfaceConfig
+		Expected string
+	}{
+		{
+			Name: "method signature",
+			Config: InterfaceConfig{Methods: []MethodSignature{
+				{Name: "Read", ParamTypes: []NamedType{{Type: "context.Context"}}, ReturnTypes: []string{"error"}},
+			}},
+			Expected: "Reader",
+		},
+		{Name: "embeds", Config: InterfaceConfig{Embeds: []string{"io.Closer"}}, Expected: "ReadCloser"},
+		{Name: "type set", Config: InterfaceConfig{TypeSet: []string{"~int"}}, Expected: "Number"},
+		{Name: "no methods", Config: InterfaceConfig{NoMethods: &noMethods, NoEmbeds: &noMethods}, Expected: "Number"},
+		{Name: "exact methods", Config: InterfaceConfig{Methods: []MethodSignature{{Name: "Keys"}}, Exact: true}, Expected: "ReadCloser"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			interfaceNode, err := ScoutInterface(path, tt.Config)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.Expected, interfaceNode.Name())
+		})
+	}
+
+	interfaceNodes, err := ScoutInterfaces(path, InterfaceConfig{})
+	assert.NoError(t, err)
+	assert.Len(t, interfaceNodes, 4)
+
+	_, err = ScoutInterface(path, InterfaceConfig{Methods: []MethodSignature{{Name: "Write"}}})
+	assert.Error(t, err)
+}
+
+func TestScoutImplementations(t *testing.T) {
+	path := filepath.Join("testdata", "scout_implements.go")
+
+	implementsNodes, err := ScoutImplementations(path, ImplementsConfig{Interface: "Store"})
+	assert.NoError(t, err)
+	receivers := make([]string, 0, len(implementsNodes))
+	for _, implementsNode := range implementsNodes {
+		receivers = append(receivers, implementsNode.Receiver())
+	}
+	assert.ElementsMatch(t, []string{"*MemStore", "FileStore", "*CachedStore", "SharedStore"}, receivers)
+
+	implementsNode, err := ScoutImplementation(path, ImplementsConfig{Interface: "io.Reader"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Buffer", implementsNode.Name())
+	assert.False(t, implementsNode.PointerReceiver)
+	assert.Equal(t, "io.Reader", implementsNode.Interface)
+
+	implementsNodes, err = ScoutImplementations(path, ImplementsConfig{Interface: "io.Closer"})
+	assert.NoError(t, err)
+	assert.Len(t, implementsNodes, 5)
+
+	implementsNode, err = ScoutImplementation(path, ImplementsConfig{Interface: "io.Writer"})
+	assert.NoError(t, err)
+	assert.Equal(t, "LogBuffer", implementsNode.Receiver())
+
+	_, err = ScoutImplementations(path, ImplementsConfig{Interface: "Missing"})
+	assert.Error(t, err)
+
+	_, err = ScoutImplementations(path, ImplementsConfig{})
+	assert.Error(t, err)
+}
+
+func TestScoutConsts(t *testing.T) {
+	path := filepath.Join("testdata", "scout_consts.go")
+
+	enumNode, err := ScoutConst(path, ConstConfig{Name: "Weekday"})
+	assert.NoError(t, err)
+	assert.True(t, enumNode.IsEnum())
+	assert.Equal(t, "Weekday", enumNode.Type())
+	assert.Equal(t, "Days of the week.", enumNode.Node.Comment)
+	assert.Equal(
+		t,
+		[]ConstValue{
+			{Name: "Sunday", Type: "Weekday", Value: "iota", Evaluated: "0", Explicit: true, Comment: "first day"},
+			{Name: "Monday", Type: "Weekday", Value: "iota", Evaluated: "1"},
+			{Name: "Tuesday", Type: "Weekday", Value: "iota", Evaluated: "2"},
+		},
+		enumNode.Members,
+	)
+
+	sizeNode, err := ScoutConst(path, ConstConfig{Name: "MB"})
+	assert.NoError(t, err)
+	assert.Equal(t, "KB", sizeNode.Name())
+	assert.True(t, sizeNode.Node.Exported)
+	assert.Equal(t, "KB", sizeNode.Symbol().Name)
+	assert.Equal(t, "1048576", sizeNode.Members[2].Evaluated)
+
+	isEnum := false
+	constNodes, err := ScoutConsts(path, ConstConfig{IsEnum: &isEnum})
+	assert.NoError(t, err)
+	assert.Len(t, constNodes, 3)
+	assert.Equal(t, "MaxRetries", constNodes[0].Name())
+	assert.Equal(t, "MaxRetries is the retry limit.", constNodes[0].Node.Comment)
+	assert.Equal(t, "const MaxRetries = 3", constNodes[0].Code())
+
+	exported := false
+	constNodes, err = ScoutConsts(path, ConstConfig{Exported: &exported, IsEnum: &isEnum})
+	assert.NoError(t, err)
+	assert.Len(t, constNodes, 2)
+	assert.Equal(t, "5 * time.Second", constNodes[1].Members[0].Value)
+	assert.Equal(t, "", constNodes[1].Members[0].Evaluated)
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		filepath.Join("a", "a.go"): "package a\n\nconst Base = 1\n",
+		filepath.Join("b", "b.go"): "package b\n\nconst Next = Base + 1\n",
+	} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644))
+	}
+	nextNode, err := ScoutConst(dir+"/...", ConstConfig{Name: "Next"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", nextNode.Members[0].Evaluated)
+}
+
+func TestScoutVars(t *testing.T) {
+	path := filepath.Join("testdata", "scout_consts.go")
+
+	varNodes, err := ScoutVars(path, VarConfig{})
+	assert.NoError(t, err)
+	assert.Len(t, varNodes, 3)
+
+	exported := true
+	varNode, err := ScoutVar(path, VarConfig{Exported: &exported})
+	assert.NoError(t, err)
+	assert.Equal(t, "DefaultZone", varNode.Name())
+	assert.Equal(t, `"UTC"`, varNode.Value())
+	assert.Equal(t, "DefaultZone is the default time zone.", varNode.Node.Comment)
+
+	hasValue := false
+	varNode, err = ScoutVar(path, VarConfig{HasValue: &hasValue})
+	assert.NoError(t, err)
+	assert.Equal(t, "cache", varNode.Name())
+	assert.Equal(t, "map[string]Weekday", varNode.Type())
+
+	varNode, err = ScoutVar(path, VarConfig{Type: "int"})
+	assert.NoError(t, err)
+	assert.Equal(t, "counter", varNode.Name())
+	assert.Equal(t, "var counter int = 1", varNode.Code())
+}
+
+func TestScoutTypes(t *testing.T) {
+	path := filepath.Join("testdata", "scout_types.go")
+	tests := []struct {
+		Kind     TypeKind
+		Expected []string
+	}{
+		{KindBasic, []string{"Celsius"}},
+		{KindFunc, []string{"HandlerFunc"}},
+		{KindSlice, []string{"IDs"}},
+		{KindMap, []string{"Lookup"}},
+		{KindChan, []string{"Events"}},
+		{KindPointer, []string{"Ref"}},
+		{KindArray, []string{"Grid"}},
+		{KindAlias, []string{"Temp"}},
+		{KindNamed, []string{"Status"}},
+		{KindStruct, []string{"point"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.Kind), func(t *testing.T) {
+			typeNodes, err := ScoutTypes(path, TypeConfig{Kind: tt.Kind})
+			assert.NoError(t, err)
+			names := make([]string, 0, len(typeNodes))
+			for _, typeNode := range typeNodes {
+				names = append(names, typeNode.Name())
+			}
+			assert.Equal(t, tt.Expected, names)
+		})
+	}
+
+	typeNode, err := ScoutType(path, TypeConfig{Methods: []string{"ServeHTTP"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "HandlerFunc", typeNode.Name())
+	assert.Equal(t, "func(w http.ResponseWriter, r *http.Request)", typeNode.Underlying())
+
+	typeNode, err = ScoutType(path, TypeConfig{Underlying: "[]string"})
+	assert.NoError(t, err)
+	assert.Equal(t, "IDs", typeNode.Name())
+	assert.Equal(t, "IDs is a list of identifiers.", typeNode.Node.Comment)
+	assert.Equal(t, "type IDs []string", typeNode.Code())
+
+	noMethods := false
+	typeNodes, err := ScoutTypes(path, TypeConfig{NoMethods: &noMethods})
+	assert.NoError(t, err)
+	assert.Len(t, typeNodes, 2)
+
+	_, err = ScoutTypes(path, TypeConfig{Kind: "tuple"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package codescout
+
+import (
+	"errors"
+	"fmt"
+	"go/scanner"
+	"strings"
+)
+
+// ParseError describes a syntax error found while parsing a Go source file.
+type ParseError struct {
+	// File in which the syntax error occurred
+	File string
+	// Line number of the syntax error
+	Line int
+	// Column number of the syntax error
+	Column int
+	// Message reported by the parser
+	Msg string
+}
+
+// Error formats the parse error as "file:line:column: message".
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Msg)
+}
+
+// ParseErrors is the list of syntax errors reported alongside the results of a partial scout.
+type ParseErrors []*ParseError
+
+// Error joins every parse error onto its own line.
+func (e ParseErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, parseErr := range e {
+		messages = append(messages, parseErr.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap exposes the individual parse errors to errors.Is and errors.As.
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, parseErr := range e {
+		errs = append(errs, parseErr)
+	}
+	return errs
+}
+
+// newParseErrors converts the error returned by go/parser into ParseErrors, reporting
+// false if the error was not a syntax error (e.g. the file could not be read).
+func newParseErrors(err error) (ParseErrors, bool) {
+	var errorList scanner.ErrorList
+	if !errors.As(err, &errorList) {
+		return nil, false
+	}
+
+	parseErrs := make(ParseErrors, 0, len(errorList))
+	for _, scanErr := range errorList {
+		parseErrs = append(parseErrs, &ParseError{
+			File:   scanErr.Pos.Filename,
+			Line:   scanErr.Pos.Line,
+			Column: scanErr.Pos.Column,
+			Msg:    scanErr.Msg,
+		})
+	}
+	return parseErrs, true
+}
+
+// isPartialResult reports whether err only carries the syntax errors of a partial scout.
+func isPartialResult(err error) bool {
+	var parseErrs ParseErrors
+	return errors.As(err, &parseErrs)
+}
@@ -0,0 +1,30 @@
+package codescout
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewParseErrors(t *testing.T) {
+	fset := token.NewFileSet()
+	_, err := parser.ParseFile(fset, "bad.go", "package bad\nfunc (", parser.AllErrors)
+
+	parseErrs, ok := newParseErrors(err)
+	assert.True(t, ok)
+	assert.NotEmpty(t, parseErrs)
+	assert.Equal(t, "bad.go", parseErrs[0].File)
+	assert.Equal(t, 2, parseErrs[0].Line)
+	assert.Contains(t, parseErrs.Error(), "bad.go:2:")
+
+	var parseErr *ParseError
+	assert.True(t, errors.As(parseErrs, &parseErr))
+	assert.True(t, isPartialResult(parseErrs))
+	assert.False(t, isPartialResult(parseErr))
+
+	_, ok = newParseErrors(errors.New("open bad.go: no such file"))
+	assert.False(t, ok)
+}
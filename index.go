@@ -0,0 +1,572 @@
+package codescout
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/galactixx/codescout/internal/pkgutils"
+)
+
+// Index holds the functions, methods and structs declared in the code at a root path as
+// records, so that repeated lookups are answered without parsing the code again. It is
+// brought up to date with Update, which only re-scouts the packages whose files changed,
+// and persisted with Save and LoadIndex. The nodes looked up are rebuilt from the records
+// once, on the first lookup after the index is loaded or changed by Update.
+type Index struct {
+	// Root path the index covers: a file, directory, "./..." pattern or package import path.
+	Root string `json:"root"`
+	// Schema is the RecordSchemaVersion the records were produced with.
+	Schema int `json:"schema"`
+	// Indexed files by path.
+	Files map[string]*IndexedFile `json:"files"`
+
+	mu    sync.Mutex
+	nodes *indexedNodes
+}
+
+// indexedNodes holds the nodes rebuilt from the records of an index, in path order.
+type indexedNodes struct {
+	functions []*FuncNode
+	methods   []*MethodNode
+	structs   []*StructNode
+}
+
+// IndexedFile holds the records of the declarations in a file, together with the
+// modification time, size and content hash used to tell whether the file changed.
+type IndexedFile struct {
+	// Modification time of the file when it was indexed.
+	ModTime time.Time `json:"mod_time"`
+	// Size of the file in bytes when it was indexed.
+	Size int64 `json:"size"`
+	// Hex encoded SHA-256 hash of the file contents.
+	Hash string `json:"hash"`
+	// Functions declared in the file.
+	Functions []FuncRecord `json:"functions"`
+	// Methods declared in the file.
+	Methods []MethodRecord `json:"methods"`
+	// Structs declared in the file, with the methods declared on them in the same package.
+	Structs []StructRecord `json:"structs"`
+}
+
+// fileState is the modification time, size and hash of a file on disk.
+type fileState struct {
+	modTime time.Time
+	size    int64
+	hash    string
+}
+
+// NewIndex returns an empty index of the code at root, filled in by Update.
+func NewIndex(root string) *Index {
+	return &Index{Root: root, Schema: RecordSchemaVersion, Files: make(map[string]*IndexedFile)}
+}
+
+// LoadIndex reads the index saved at path. An empty index of root is returned when there is
+// no file at path, or when the saved index was produced with a different RecordSchemaVersion,
+// so that the next Update rebuilds it. An index saved for a different root is an error, so
+// that the index of one root is never overwritten by another.
+func LoadIndex(path string, root string) (*Index, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewIndex(root), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var index Index
+	if unmarshalErr := json.Unmarshal(data, &index); unmarshalErr != nil {
+		return nil, fmt.Errorf("index %s could not be read: %w", path, unmarshalErr)
+	}
+	if index.Root != root {
+		return nil, fmt.Errorf("index %s covers %s, not %s", path, index.Root, root)
+	}
+	if index.Schema != RecordSchemaVersion || index.Files == nil {
+		return NewIndex(root), nil
+	}
+	return &index, nil
+}
+
+// Save writes the index to path, creating its directory if needed. The index is written to a
+// temporary file first and renamed into place, so a concurrent reader never sees half of it.
+func (x *Index) Save(path string) error {
+	data, err := json.Marshal(x)
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if mkdirErr := os.MkdirAll(dir, 0o755); mkdirErr != nil {
+			return mkdirErr
+		}
+	}
+
+	tmp := path + ".tmp"
+	if writeErr := os.WriteFile(tmp, data, 0o644); writeErr != nil {
+		return writeErr
+	}
+	return os.Rename(tmp, path)
+}
+
+// Update brings the index up to date with the code at Root and returns the number of files
+// whose entries changed. A file is only hashed when its modification time or size changed,
+// and every package containing a new, removed or modified file is scouted again, since its
+// structs collect methods and promoted members from the other files of the package. Files
+// with syntax errors are indexed with the declarations that did parse.
+func (x *Index) Update() (int, error) { return x.UpdateWithContext(context.Background()) }
+
+// UpdateWithContext is Update with a context, leaving the index unchanged if it is cancelled.
+func (x *Index) UpdateWithContext(ctx context.Context) (int, error) {
+	files, err := pkgutils.ResolveGoFiles(x.Root)
+	if err != nil {
+		return 0, err
+	}
+
+	states := make(map[string]fileState, len(files))
+	staleDirs := make(map[string]bool)
+	touched := make(map[string]fileState)
+	for _, path := range files {
+		info, statErr := os.Stat(path)
+		if statErr != nil {
+			return 0, statErr
+		}
+		entry, indexed := x.Files[path]
+		if indexed && entry.ModTime.Equal(info.ModTime()) && entry.Size == info.Size() {
+			continue
+		}
+
+		hash, hashErr := fileHash(path)
+		if hashErr != nil {
+			return 0, hashErr
+		}
+		state := fileState{modTime: info.ModTime(), size: info.Size(), hash: hash}
+		states[path] = state
+		if indexed && entry.Hash == hash {
+			touched[path] = state
+			continue
+		}
+		staleDirs[filepath.Dir(path)] = true
+	}
+
+	current := make(map[string]bool, len(files))
+	for _, path := range files {
+		current[path] = true
+	}
+	removed := make([]string, 0)
+	for path := range x.Files {
+		if !current[path] {
+			removed = append(removed, path)
+			staleDirs[filepath.Dir(path)] = true
+		}
+	}
+
+	stale := make([]string, 0)
+	for _, path := range files {
+		if staleDirs[filepath.Dir(path)] {
+			// A touched file of a stale package is indexed again, and counted once.
+			stale = append(stale, path)
+			delete(touched, path)
+		}
+	}
+	entries, err := indexFiles(ctx, stale, states)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, path := range removed {
+		delete(x.Files, path)
+	}
+	for path, state := range touched {
+		x.Files[path].ModTime, x.Files[path].Size = state.modTime, state.size
+	}
+	for path, entry := range entries {
+		x.Files[path] = entry
+	}
+
+	updated := len(removed) + len(touched) + len(entries)
+	if updated > 0 {
+		x.mu.Lock()
+		x.nodes = nil
+		x.mu.Unlock()
+	}
+	return updated, nil
+}
+
+// indexFiles scouts the functions, methods and structs of the files and returns an entry for
+// each file, reusing the states already computed for some of them.
+func indexFiles(ctx context.Context, files []string, states map[string]fileState) (map[string]*IndexedFile, error) {
+	entries := make(map[string]*IndexedFile, len(files))
+	if len(files) == 0 {
+		return entries, nil
+	}
+	for _, path := range files {
+		state, ok := states[path]
+		if !ok {
+			info, statErr := os.Stat(path)
+			if statErr != nil {
+				return nil, statErr
+			}
+			hash, hashErr := fileHash(path)
+			if hashErr != nil {
+				return nil, hashErr
+			}
+			state = fileState{modTime: info.ModTime(), size: info.Size(), hash: hash}
+		}
+		entries[path] = &IndexedFile{
+			ModTime: state.modTime, Size: state.size, Hash: state.hash,
+			Functions: []FuncRecord{}, Methods: []MethodRecord{}, Structs: []StructRecord{},
+		}
+	}
+
+	src := fileListSource{Files: files}
+	funcNodes, err := getAllOccurrences(ctx, funcScoutSetup{Path: files[0], Source: src, Config: FuncConfig{Partial: true}})
+	if err != nil && !isPartialResult(err) {
+		return nil, err
+	}
+	for _, node := range funcNodes {
+		entries[node.Node.Path].Functions = append(entries[node.Node.Path].Functions, node.ToRecord())
+	}
+
+	methodNodes, err := getAllOccurrences(ctx, methodScoutSetup{Path: files[0], Source: src, Config: MethodConfig{Partial: true}})
+	if err != nil && !isPartialResult(err) {
+		return nil, err
+	}
+	for _, node := range methodNodes {
+		entries[node.Node.Path].Methods = append(entries[node.Node.Path].Methods, node.ToRecord())
+	}
+
+	structNodes, err := getAllOccurrences(ctx, structScoutSetup{Path: files[0], Source: src, Config: StructConfig{Partial: true}})
+	if err != nil && !isPartialResult(err) {
+		return nil, err
+	}
+	for _, node := range structNodes {
+		entries[node.Node.Path].Structs = append(entries[node.Node.Path].Structs, node.ToRecord())
+	}
+	return entries, nil
+}
+
+// fileHash returns the hex encoded SHA-256 hash of the file contents.
+func fileHash(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// paths returns the indexed file paths in order.
+func (x *Index) paths() []string {
+	paths := make([]string, 0, len(x.Files))
+	for path := range x.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// rebuilt returns the nodes rebuilt from the records, rebuilding them if the index was loaded
+// or changed since the last lookup. The records are parsed into a single file set, each under
+// the path and at the position it was declared at.
+func (x *Index) rebuilt() (*indexedNodes, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.nodes != nil {
+		return x.nodes, nil
+	}
+
+	fset := token.NewFileSet()
+	nodes := &indexedNodes{functions: []*FuncNode{}, methods: []*MethodNode{}, structs: []*StructNode{}}
+	for _, path := range x.paths() {
+		for _, record := range x.Files[path].Functions {
+			node, err := record.toNode(fset)
+			if err != nil {
+				return nil, err
+			}
+			nodes.functions = append(nodes.functions, node)
+		}
+		for _, record := range x.Files[path].Methods {
+			node, err := record.toNode(fset)
+			if err != nil {
+				return nil, err
+			}
+			nodes.methods = append(nodes.methods, node)
+		}
+		for _, record := range x.Files[path].Structs {
+			node, err := record.toNode(fset)
+			if err != nil {
+				return nil, err
+			}
+			nodes.structs = append(nodes.structs, node)
+		}
+	}
+	x.nodes = nodes
+	return nodes, nil
+}
+
+// validateIndexTypeMatch returns an error if types are to be compared semantically, which
+// requires type-checking the code rather than reading the index.
+func validateIndexTypeMatch(mode TypeMatchMode) error {
+	if mode != "" && mode != TextualTypes {
+		return fmt.Errorf("TypeMatch %s is not supported by an index", mode)
+	}
+	return nil
+}
+
+// firstIndexed returns the first node, or an error naming the symbol when there is none.
+func firstIndexed[T any](nodes []*T, err error, symbol string) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no %s was found based on configuration", symbol)
+	}
+	return nodes[0], nil
+}
+
+// ScoutFunction returns the first indexed function matching the config.
+func (x *Index) ScoutFunction(config FuncConfig) (*FuncNode, error) {
+	nodes, err := x.ScoutFunctions(config)
+	return firstIndexed(nodes, err, "function")
+}
+
+// ScoutFunctions returns all indexed functions matching the config, which is validated and
+// applied as for ScoutFunctions. Types are always compared textually, so TypeMatch must be
+// left unset, and Partial and Workers have no effect.
+func (x *Index) ScoutFunctions(config FuncConfig) ([]*FuncNode, error) {
+	patterns, validateErr := (funcScoutSetup{Config: config}).validate()
+	if validateErr != nil {
+		return nil, validateErr
+	}
+	if typeMatchErr := validateIndexTypeMatch(config.TypeMatch); typeMatchErr != nil {
+		return nil, typeMatchErr
+	}
+
+	indexed, err := x.rebuilt()
+	if err != nil {
+		return nil, err
+	}
+	inspector := funcInspector{Config: config, patterns: patterns}
+	nodes := make([]*FuncNode, 0)
+	for _, node := range indexed.functions {
+		if inspector.isNodeMatch(node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return sortNodes(nodes, config.Sort, func(node *FuncNode) BaseNode { return node.Node }), nil
+}
+
+// ScoutMethod returns the first indexed method matching the config.
+func (x *Index) ScoutMethod(config MethodConfig) (*MethodNode, error) {
+	nodes, err := x.ScoutMethods(config)
+	return firstIndexed(nodes, err, "method")
+}
+
+// ScoutMethods returns all indexed methods matching the config, with the same limitations as
+// ScoutFunctions.
+func (x *Index) ScoutMethods(config MethodConfig) ([]*MethodNode, error) {
+	patterns, validateErr := (methodScoutSetup{Config: config}).validate()
+	if validateErr != nil {
+		return nil, validateErr
+	}
+	if typeMatchErr := validateIndexTypeMatch(config.TypeMatch); typeMatchErr != nil {
+		return nil, typeMatchErr
+	}
+
+	indexed, err := x.rebuilt()
+	if err != nil {
+		return nil, err
+	}
+	inspector := methodInspector{Config: config, patterns: patterns}
+	nodes := make([]*MethodNode, 0)
+	for _, node := range indexed.methods {
+		if inspector.isNodeMatch(node) && inspector.isAttrsMatch(node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return sortNodes(nodes, config.Sort, func(node *MethodNode) BaseNode { return node.Node }), nil
+}
+
+// ScoutStruct returns the first indexed struct matching the config.
+func (x *Index) ScoutStruct(config StructConfig) (*StructNode, error) {
+	nodes, err := x.ScoutStructs(config)
+	return firstIndexed(nodes, err, "struct")
+}
+
+// ScoutStructs returns all indexed structs matching the config, with the same limitations as
+// ScoutFunctions.
+func (x *Index) ScoutStructs(config StructConfig) ([]*StructNode, error) {
+	patterns, validateErr := (structScoutSetup{Config: config}).validate()
+	if validateErr != nil {
+		return nil, validateErr
+	}
+	if typeMatchErr := validateIndexTypeMatch(config.TypeMatch); typeMatchErr != nil {
+		return nil, typeMatchErr
+	}
+
+	indexed, err := x.rebuilt()
+	if err != nil {
+		return nil, err
+	}
+	inspector := structInspector{Config: config, patterns: patterns}
+	nodes := make([]*StructNode, 0)
+	for _, node := range indexed.structs {
+		if inspector.isNodeMatch(node) && inspector.isMethodsMatch(node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return sortNodes(nodes, config.Sort, func(node *StructNode) BaseNode { return node.Node }), nil
+}
+
+// parseRecordCode parses the code of a record on its own, so that a node can be rebuilt from it.
+func parseRecordCode(fset *token.FileSet, record BaseRecord, code string) (*ast.File, error) {
+	file, err := parser.ParseFile(fset, record.Path, "package index\n\n"+code, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("indexed code of %s could not be parsed: %w", record.Name, err)
+	}
+	return file, nil
+}
+
+// alignPositions makes the positions from the start of the rebuilt declaration on report the
+// path, line and column of the record, so they point at the declaration in the scouted code.
+func alignPositions(fset *token.FileSet, start token.Pos, record BaseRecord) {
+	file := fset.File(start)
+	file.AddLineColumnInfo(file.Offset(start), record.Path, record.Range.Start.Line, record.Range.Start.Column)
+}
+
+// toNode converts the BaseRecord back into the BaseNode it was produced from.
+func (b BaseRecord) toNode() BaseNode {
+	return BaseNode{
+		Name:           b.Name,
+		Path:           b.Path,
+		Line:           b.Line,
+		Characters:     b.Column,
+		Exported:       b.Exported,
+		Comment:        b.Comment,
+		Range:          b.Range,
+		DocRange:       b.DocRange,
+		SignatureRange: b.SignatureRange,
+		BodyRange:      b.BodyRange,
+	}
+}
+
+// funcDecl returns the function or method declaration parsed from the record's code.
+func (r FuncRecord) funcDecl(fset *token.FileSet) (*ast.FuncDecl, error) {
+	file, err := parseRecordCode(fset, r.BaseRecord, r.Code)
+	if err != nil {
+		return nil, err
+	}
+	for _, decl := range file.Decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Name.Name == r.Name {
+			alignPositions(fset, funcDecl.Pos(), r.BaseRecord)
+			if funcDecl.Doc == nil {
+				funcDecl.Doc = recordDoc(file, funcDecl)
+			}
+			return funcDecl, nil
+		}
+	}
+	return nil, fmt.Errorf("indexed code of %s does not declare it", r.Name)
+}
+
+// recordDoc returns the doc comment of a function rebuilt from its record. The code of a record
+// separates the doc comment from the declaration with a blank line, as Code does, so the parser
+// does not attach it and it is the comment preceding the declaration.
+func recordDoc(file *ast.File, decl *ast.FuncDecl) *ast.CommentGroup {
+	var doc *ast.CommentGroup
+	for _, group := range file.Comments {
+		if group.End() < decl.Pos() {
+			doc = group
+		}
+	}
+	return doc
+}
+
+// toNode rebuilds the function from its record.
+func (r FuncRecord) toNode(fset *token.FileSet) (*FuncNode, error) {
+	decl, err := r.funcDecl(fset)
+	if err != nil {
+		return nil, err
+	}
+	return &FuncNode{Node: r.BaseRecord.toNode(), CallableOps: CallableOps{node: decl, fset: fset}}, nil
+}
+
+// toNode rebuilds the method from its record.
+func (r MethodRecord) toNode(fset *token.FileSet) (*MethodNode, error) {
+	decl, err := r.funcDecl(fset)
+	if err != nil {
+		return nil, err
+	}
+	node := &MethodNode{
+		Node:           r.BaseRecord.toNode(),
+		CallableOps:    CallableOps{node: decl, fset: fset},
+		fieldsAccessed: make(map[string]*int),
+		methodsCalled:  make(map[string]*int),
+		typeParams:     r.TypeParams,
+	}
+	for _, field := range r.FieldsAccessed {
+		node.addMethodField(field)
+	}
+	for _, method := range r.MethodsCalled {
+		node.addMethodCall(method)
+	}
+	return node, nil
+}
+
+// toNode rebuilds the struct and its methods from its record.
+func (r StructRecord) toNode(fset *token.FileSet) (*StructNode, error) {
+	file, err := parseRecordCode(fset, r.BaseRecord, r.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &StructNode{
+		Node:            r.BaseRecord.toNode(),
+		Methods:         make([]*MethodNode, 0, len(r.Methods)),
+		promotedFields:  r.PromotedFields,
+		promotedMethods: r.PromotedMethods,
+		fset:            fset,
+	}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != r.Name {
+				continue
+			}
+			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+				node.node, node.spec, node.genNode = structType, typeSpec, genDecl
+				start := genDecl.Pos()
+				if genDecl.Lparen.IsValid() {
+					start = typeSpec.Pos()
+				}
+				alignPositions(fset, start, r.BaseRecord)
+			}
+		}
+	}
+	if node.node == nil {
+		return nil, fmt.Errorf("indexed code of %s does not declare it", r.Name)
+	}
+
+	for _, methodRecord := range r.Methods {
+		method, methodErr := methodRecord.toNode(fset)
+		if methodErr != nil {
+			return nil, methodErr
+		}
+		node.Methods = append(node.Methods, method)
+	}
+	return node, nil
+}
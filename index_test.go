@@ -0,0 +1,119 @@
+package codescout
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeIndexFile writes a Go source file and moves its modification time forward, so a change
+// is noticed even within the file system's time resolution.
+func writeIndexFile(t *testing.T, path string, src string, age time.Duration) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+	modTime := time.Now().Add(age)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestIndex(t *testing.T) {
+	dir := t.TempDir()
+	server := filepath.Join(dir, "server.go")
+	handlers := filepath.Join(dir, "handlers.go")
+	writeIndexFile(t, server, "package app\n\n// Server serves requests.\ntype Server struct {\n\tAddr string `json:\"addr\"`\n}\n\n// NewServer returns a server.\nfunc NewServer(addr string) *Server { return &Server{Addr: addr} }\n", -time.Hour)
+	writeIndexFile(t, handlers, "package app\n\nfunc (s *Server) Start() error {\n\t_ = s.Addr\n\treturn nil\n}\n", -time.Hour)
+
+	index := NewIndex(dir)
+	updated, err := index.Update()
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+
+	fn, err := index.ScoutFunction(FuncConfig{Name: "NewServer", ReturnTypes: []string{"*Server"}})
+	require.NoError(t, err)
+	assert.Equal(t, server, fn.Node.Path)
+	assert.Equal(t, 9, fn.Node.Line)
+	assert.Equal(t, "Server serves requests.", index.Files[server].Structs[0].Comment)
+	assert.Equal(t, "func NewServer(addr string) *Server", fn.CallableOps.Signature())
+	assert.Equal(t, []NamedType{{Name: "addr", Type: "string"}}, fn.CallableOps.Parameters())
+	position := fn.CallableOps.fset.Position(fn.CallableOps.node.Pos())
+	assert.Equal(t, server, position.Filename)
+	assert.Equal(t, 9, position.Line)
+	assert.Equal(t, 1, position.Column)
+
+	// A rebuilt function keeps its doc comment, so it is recorded as a live scout records it.
+	live, err := ScoutFunction(server, FuncConfig{Name: "NewServer"})
+	require.NoError(t, err)
+	assert.Equal(t, "// NewServer returns a server.\n", fn.CallableOps.Comments())
+	assert.Equal(t, live.CallableOps.Code(), fn.CallableOps.Code())
+	assert.Equal(t, live.ToRecord(), fn.ToRecord())
+
+	// Lookups share the nodes rebuilt once, until Update changes the index.
+	again, err := index.ScoutFunction(FuncConfig{Name: "NewServer"})
+	require.NoError(t, err)
+	assert.Same(t, fn, again)
+
+	method, err := index.ScoutMethod(MethodConfig{Receiver: "Server", Fields: []string{"Addr"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Start", method.Name())
+	assert.True(t, method.HasPointerReceiver())
+
+	structNode, err := index.ScoutStruct(StructConfig{Name: "Server"})
+	require.NoError(t, err)
+	assert.Len(t, structNode.Methods, 1)
+	assert.Equal(t, "addr", structNode.Fields()[0].StructTag().Get("json"))
+
+	_, err = index.ScoutFunction(FuncConfig{Name: "Missing"})
+	assert.EqualError(t, err, "no function was found based on configuration")
+	_, err = index.ScoutFunctions(FuncConfig{TypeMatch: IdenticalTypes})
+	assert.EqualError(t, err, "TypeMatch identical is not supported by an index")
+
+	// Saving and loading keeps every entry, and nothing changed since.
+	path := filepath.Join(dir, ".codescout", "index.json")
+	require.NoError(t, index.Save(path))
+	loaded, err := LoadIndex(path, dir)
+	require.NoError(t, err)
+	updated, err = loaded.Update()
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated)
+	assert.Equal(t, index.Files[server].Hash, loaded.Files[server].Hash)
+
+	// Touching a file refreshes its metadata without re-scouting its package.
+	writeIndexFile(t, handlers, "package app\n\nfunc (s *Server) Start() error {\n\t_ = s.Addr\n\treturn nil\n}\n", 0)
+	updated, err = loaded.Update()
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+
+	// Changing a file re-scouts the whole package, so the struct in the other file sees the
+	// removed method. The other file, also touched, is counted once.
+	serverSrc, err := os.ReadFile(server)
+	require.NoError(t, err)
+	writeIndexFile(t, server, string(serverSrc), 0)
+	writeIndexFile(t, handlers, "package app\n\nfunc Stop() {}\n", time.Hour)
+	updated, err = loaded.Update()
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+	structNode, err = loaded.ScoutStruct(StructConfig{Name: "Server"})
+	require.NoError(t, err)
+	assert.Empty(t, structNode.Methods)
+	_, err = loaded.ScoutMethod(MethodConfig{Name: "Start"})
+	assert.Error(t, err)
+
+	// Removing a file drops its entry.
+	require.NoError(t, os.Remove(handlers))
+	updated, err = loaded.Update()
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+	assert.NotContains(t, loaded.Files, handlers)
+
+	rebuilt, err := loaded.ScoutFunction(FuncConfig{Name: "NewServer"})
+	require.NoError(t, err)
+	assert.NotSame(t, fn, rebuilt)
+
+	// An index saved for another root is not loaded.
+	other := filepath.Join(dir, "other")
+	_, err = LoadIndex(path, other)
+	assert.EqualError(t, err, "index "+path+" covers "+dir+", not "+other)
+}
@@ -1,9 +1,17 @@
 package codescout
 
 import (
+	"context"
+	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/galactixx/codescout/internal/pkgutils"
 )
@@ -13,19 +21,116 @@ type inspector[T any] interface {
 	isNodeMatch(name *T) bool
 	appendNode(node *T)
 	inspector(n ast.Node) bool
-	inspect()
+	inspect(ctx context.Context) error
 	getNodes() []*T
 }
 
 // baseInspector provides shared utilities for AST traversal and node metadata extraction.
 type baseInspector struct {
-	Path string
-	Fset *token.FileSet
+	Path    string
+	Files   []string
+	Source  source
+	Fset    *token.FileSet
+	Partial bool
+	Types   *typeChecker
+	Workers int
+
+	// done, if set, hands out the matches found so far and reports whether the consumer
+	// stopped, ending the inspection early.
+	done       func() bool
+	syntaxErrs ParseErrors
+}
+
+// files returns the files to inspect, falling back to Path when no file list was resolved.
+func (i baseInspector) files() []string {
+	if len(i.Files) == 0 {
+		return []string{i.Path}
+	}
+	return i.Files
+}
+
+// parsed sets path as the current Path and handles the result of parsing it. Syntax errors are
+// returned as a *ParseError, unless Partial is set, in which case they are recorded and
+// whatever part of the AST did parse is returned.
+func (i *baseInspector) parsed(path string, node *ast.File, err error) (*ast.File, error) {
+	i.Path = path
+	if err == nil {
+		return node, nil
+	}
+
+	parseErrs, isSyntaxErr := newParseErrors(err)
+	if !isSyntaxErr {
+		return nil, err
+	}
+	if !i.Partial {
+		return nil, parseErrs[0]
+	}
+	i.syntaxErrs = append(i.syntaxErrs, parseErrs...)
+	return node, nil
+}
+
+// parseSource parses the file at path, reading its contents from Source when one is set.
+func (i baseInspector) parseSource(path string) (*ast.File, error) {
+	if i.Source == nil {
+		return pkgutils.ParseFile(path, i.Fset)
+	}
+	src, err := i.Source.read(path)
+	if err != nil {
+		return nil, err
+	}
+	return pkgutils.ParseSource(path, src, i.Fset)
+}
+
+// callableValidators returns the validators matching configured parameter and return types
+// against the declaration, comparing checked types when a type checker is set.
+func (i baseInspector) callableValidators(decl *ast.FuncDecl) (func([]NamedType, []NamedType) bool, func([]string, []string) bool) {
+	if i.Types == nil {
+		return namedTypesMatch, returnMatch
+	}
+	params := i.Types.namedTypesValidator(i.Path, i.Types.paramsOf(i.Path, decl), false)
+	results := i.Types.typesValidator(i.Path, i.Types.resultsOf(i.Path, decl))
+	return params, results
+}
+
+// fieldsValidator returns the validator matching configured field types against the struct,
+// comparing checked types when a type checker is set.
+func (i baseInspector) fieldsValidator(spec *ast.TypeSpec) func([]NamedType, []NamedType) bool {
+	if i.Types == nil {
+		return namedTypesMatch
+	}
+	return i.Types.namedTypesValidator(i.Path, i.Types.fieldsOf(i.Path, spec), false)
+}
+
+// orderedParamsValidator returns the validator matching configured parameter types against the
+// parameters of the declaration at the same positions, comparing checked types when a type
+// checker is set.
+func (i baseInspector) orderedParamsValidator(decl *ast.FuncDecl) func([]NamedType, []NamedType) bool {
+	if i.Types == nil {
+		return orderedNamedTypesMatch
+	}
+	return i.Types.namedTypesValidator(i.Path, i.Types.paramsOf(i.Path, decl), true)
+}
+
+// stopped reports whether the consumer of a streamed inspection stopped.
+func (i baseInspector) stopped() bool { return i.done != nil && i.done() }
+
+// partialErr returns the syntax errors recorded during a partial inspection, if any.
+func (i baseInspector) partialErr() error {
+	if len(i.syntaxErrs) == 0 {
+		return nil
+	}
+	return i.syntaxErrs
 }
 
 // inspect traverses the AST and applies a list of inspector functions to each node.
-func (i baseInspector) inspect(node ast.Node, inspectors []func(n ast.Node) bool) {
+func (i baseInspector) inspect(node *ast.File, inspectors []func(n ast.Node) bool) {
+	if node == nil {
+		return
+	}
 	ast.Inspect(node, func(n ast.Node) bool {
+		if i.stopped() {
+			return false
+		}
 		for _, inspector := range inspectors {
 			inspector(n)
 		}
@@ -50,9 +155,61 @@ func (i baseInspector) newNode(name string, node ast.Node, comment string) BaseN
 func (i baseInspector) getCallableNodes(name string, node ast.Node, comment string) (BaseNode, *ast.FuncDecl) {
 	baseNode := i.newNode(name, node, comment)
 	funcNode := node.(*ast.FuncDecl)
+	baseNode.Range = i.span(funcNode.Pos(), funcNode.End())
+	baseNode.DocRange = i.docSpan(funcNode.Doc)
+	baseNode.SignatureRange = i.span(funcNode.Pos(), funcNode.Type.End())
+	if funcNode.Body != nil {
+		baseNode.BodyRange = i.span(funcNode.Body.Pos(), funcNode.Body.End())
+	}
 	return baseNode, funcNode
 }
 
+// setTypeRanges sets the ranges of a node declared by a type spec, with the body spanning
+// from bodyStart to bodyEnd.
+func (i baseInspector) setTypeRanges(node *BaseNode, gen *ast.GenDecl, spec *ast.TypeSpec, bodyStart, bodyEnd token.Pos) {
+	i.setValueRanges(node, gen, spec, spec.Doc)
+	signatureEnd := spec.Name.End()
+	if spec.TypeParams != nil {
+		signatureEnd = spec.TypeParams.End()
+	}
+	node.SignatureRange = i.span(spec.Name.Pos(), signatureEnd)
+	node.BodyRange = i.span(bodyStart, bodyEnd)
+}
+
+// setValueRanges sets the declaration and doc comment ranges of a node declared by a spec,
+// which span the whole declaration when it is not a parenthesised group.
+func (i baseInspector) setValueRanges(node *BaseNode, gen *ast.GenDecl, spec ast.Spec, doc *ast.CommentGroup) {
+	if !gen.Lparen.IsValid() {
+		node.Range = i.span(gen.Pos(), gen.End())
+		node.DocRange = i.docSpan(gen.Doc)
+		return
+	}
+	node.Range = i.span(spec.Pos(), spec.End())
+	node.DocRange = i.docSpan(doc)
+}
+
+// docSpan returns the range of a doc comment, or a zero range when there is none.
+func (i baseInspector) docSpan(doc *ast.CommentGroup) Range {
+	if doc == nil {
+		return Range{}
+	}
+	return i.span(doc.Pos(), doc.End())
+}
+
+// span returns the range between two positions, or a zero range when either is invalid.
+func (i baseInspector) span(start, end token.Pos) Range {
+	if !start.IsValid() || !end.IsValid() {
+		return Range{}
+	}
+	return Range{Start: i.position(start), End: i.position(end)}
+}
+
+// position returns the line, column and offset of a position.
+func (i baseInspector) position(pos token.Pos) Position {
+	position := i.Fset.Position(pos)
+	return Position{Line: position.Line, Column: position.Column, Offset: position.Offset}
+}
+
 // getPos returns the line and column position of the given AST node.
 func (i baseInspector) getPos(node ast.Node) (int, int) {
 	pos := i.Fset.Position(node.Pos())
@@ -63,48 +220,179 @@ func (i baseInspector) getPos(node ast.Node) (int, int) {
 
 // structInspector inspects struct declarations and associates their methods.
 type structInspector struct {
-	Nodes  map[string]*StructNode
+	Nodes  []*StructNode
 	Config StructConfig
 	Base   baseInspector
+
+	declared map[string][]*StructNode
+	patterns namePatterns
 }
 
 // isNodeMatch determines whether a StructNode matches the struct inspection configuration.
 func (i structInspector) isNodeMatch(node *StructNode) bool {
-	nameEquals := !(i.Config.Name != "" && i.Config.Name != node.Node.Name)
-	matchFields := astMatch(i.Config.FieldTypes, node.Fields(), i.Config.Exact, i.Config.NoFields, namedTypesMatch)
-	return nameEquals && matchFields.validate()
+	nameEquals := i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, node.Node.Name)
+	fieldsMatch := i.Base.fieldsValidator(node.spec)
+	matchFields := astMatch(i.Config.FieldTypes, node.Fields(), i.Config.Exact, i.Config.NoFields, fieldsMatch)
+	excludedFields := excludesMatch(i.Config.ExcludeFieldTypes, node.Fields(), fieldsMatch)
+	matchTags := tagFiltersMatch(i.Config.Tags, node.Fields())
+	matchEmbeds := astMatch(i.Config.Embeds, node.Embeds(), i.Config.Exact, i.Config.NoEmbeds, embedsMatch)
+	excludedEmbeds := embedsExcluded(i.Config.ExcludeEmbeds, node.Embeds())
+	matchTypeParams := astMatch(i.Config.TypeParams, node.TypeParams(), i.Config.Exact, i.Config.NoTypeParams, namedTypesMatch)
+	validGeneric := i.Config.IsGeneric == nil || *i.Config.IsGeneric == node.IsGeneric()
+	return nameEquals && matchFields.validate() && !excludedFields && matchTags && matchEmbeds.validate() &&
+		!excludedEmbeds && matchTypeParams.validate() && validGeneric
 }
 
-// appendNode stores a matched StructNode in the inspector's map.
-func (i *structInspector) appendNode(node *StructNode) {
-	i.Nodes[node.Node.Name] = node
+// isMethodsMatch validates the methods declared on the struct, which are only known once every
+// file has been inspected.
+func (i structInspector) isMethodsMatch(node *StructNode) bool {
+	methodNames := make([]string, 0, len(node.Methods))
+	for _, method := range node.Methods {
+		methodNames = append(methodNames, method.Name())
+	}
+	return !excludesMatch(i.Config.ExcludeMethods, methodNames, accessedMatch)
+}
+
+// structKey scopes a struct name to the package directory of the file declaring it.
+func structKey(path string, name string) string {
+	return filepath.Dir(path) + ":" + name
 }
 
+// appendNode stores a matched StructNode.
+func (i *structInspector) appendNode(node *StructNode) { i.Nodes = append(i.Nodes, node) }
+
 // inspect performs the struct inspection and attaches discovered methods to their respective structs.
-func (i *structInspector) inspect() {
-	methodsInspect := methodInspector{
-		Nodes:  []*MethodNode{},
-		Config: MethodConfig{},
-		Base:   i.Base,
+func (i *structInspector) inspect(ctx context.Context) error {
+	generics := newGenericTypes()
+	methodNodes := make([]*MethodNode, 0)
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		fileInspect := structInspector{Config: i.Config, Base: base, patterns: i.patterns}
+		methodsInspect := methodInspector{Base: base, generics: generics}
+		base.inspect(node, []func(n ast.Node) bool{fileInspect.inspector, methodsInspect.inspector})
+		return func() {
+			i.Nodes = append(i.Nodes, fileInspect.Nodes...)
+			for _, structNodes := range fileInspect.declared {
+				for _, structNode := range structNodes {
+					i.declare(structNode)
+				}
+			}
+			methodNodes = append(methodNodes, methodsInspect.Nodes...)
+		}
+	})
+	if scanErr != nil {
+		return scanErr
 	}
 
-	node := pkgutils.ParseFile(i.Base.Path, i.Base.Fset)
-	i.Base.inspect(node, []func(n ast.Node) bool{i.inspector, methodsInspect.inspector})
-
-	for _, methodNode := range methodsInspect.Nodes {
-		if structNode, ok := i.Nodes[methodNode.ReceiverType()]; ok {
+	for _, methodNode := range methodNodes {
+		key := structKey(methodNode.Node.Path, methodNode.ReceiverType())
+		for _, structNode := range i.declared[key] {
 			structNode.Methods = append(structNode.Methods, methodNode)
 		}
 	}
+
+	matched := make([]*StructNode, 0, len(i.Nodes))
+	for _, structNode := range i.Nodes {
+		if !i.isMethodsMatch(structNode) {
+			continue
+		}
+		structNode.promotedFields, structNode.promotedMethods = i.promotedMembers(structNode)
+		matched = append(matched, structNode)
+	}
+	i.Nodes = matched
+	return i.Base.partialErr()
 }
 
-// getNodes returns a slice of all matched StructNode instances.
-func (i *structInspector) getNodes() []*StructNode {
-	structNodes := make([]*StructNode, 0, len(i.Nodes))
-	for _, node := range i.Nodes {
-		structNodes = append(structNodes, node)
+// declare records every struct declared in the scouted code, matched or not, so that methods
+// and promoted members can be resolved through embeds. A name declared in more than one file
+// of a package, as with build constrained files, keeps every declaration.
+func (i *structInspector) declare(node *StructNode) {
+	if i.declared == nil {
+		i.declared = make(map[string][]*StructNode)
 	}
-	return structNodes
+	key := structKey(node.Node.Path, node.Node.Name)
+	i.declared[key] = append(i.declared[key], node)
+}
+
+// embeddedStruct returns the struct declared in the scouted code that the embedded field refers
+// to, if any. Only unqualified embeds in the same package can be resolved.
+func (i structInspector) embeddedStruct(node *StructNode, field NamedType) (*StructNode, bool) {
+	name := strings.TrimPrefix(field.Type, "*")
+	if strings.ContainsAny(name, ".[") {
+		return nil, false
+	}
+	declared := i.declared[structKey(node.Node.Path, name)]
+	if len(declared) == 0 {
+		return nil, false
+	}
+	return declared[0], true
+}
+
+// promotedMembers computes the fields and methods promoted to the struct from its embedded
+// structs, searching breadth-first so that shallower members shadow deeper ones and members
+// declared more than once at the same depth are left out as ambiguous.
+func (i structInspector) promotedMembers(node *StructNode) ([]NamedType, []string) {
+	promotedFields := make([]NamedType, 0)
+	promotedMethods := make([]string, 0)
+
+	shadowed := make(map[string]bool)
+	for _, field := range node.Fields() {
+		shadowed[field.Name] = true
+	}
+	for _, method := range node.Methods {
+		shadowed[method.Name()] = true
+	}
+
+	visited := map[*StructNode]bool{node: true}
+	level := []*StructNode{node}
+	for len(level) > 0 {
+		var next []*StructNode
+		counts := make(map[string]int)
+		var fields []NamedType
+		var methods []string
+
+		for _, levelNode := range level {
+			for _, field := range levelNode.Fields() {
+				if !field.Embedded {
+					continue
+				}
+				embedded, ok := i.embeddedStruct(levelNode, field)
+				if !ok || visited[embedded] {
+					continue
+				}
+				visited[embedded] = true
+				next = append(next, embedded)
+				for _, embeddedField := range embedded.Fields() {
+					counts[embeddedField.Name]++
+					fields = append(fields, embeddedField)
+				}
+				for _, method := range embedded.Methods {
+					counts[method.Name()]++
+					methods = append(methods, method.Name())
+				}
+			}
+		}
+
+		for _, field := range fields {
+			if !shadowed[field.Name] && counts[field.Name] == 1 {
+				promotedFields = append(promotedFields, field)
+			}
+		}
+		for _, method := range methods {
+			if !shadowed[method] && counts[method] == 1 {
+				promotedMethods = append(promotedMethods, method)
+			}
+		}
+		for name := range counts {
+			shadowed[name] = true
+		}
+		level = next
+	}
+	return promotedFields, promotedMethods
+}
+
+// getNodes returns all matched StructNode instances in the configured sort order.
+func (i *structInspector) getNodes() []*StructNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *StructNode) BaseNode { return node.Node })
 }
 
 // newStruct constructs a StructNode from its AST components.
@@ -115,6 +403,7 @@ func (i structInspector) newStruct(node ast.Node, gen *ast.GenDecl, spec *ast.Ty
 	}
 	baseNode := i.Base.newNode(spec.Name.Name, node, comment)
 	structNode := node.(*ast.StructType)
+	i.Base.setTypeRanges(&baseNode, gen, spec, structNode.Fields.Pos(), structNode.Fields.End())
 	return &StructNode{
 		Node: baseNode, node: structNode, spec: spec, genNode: gen, fset: i.Base.Fset,
 	}
@@ -131,6 +420,7 @@ func (i *structInspector) inspector(node ast.Node) bool {
 		if typeSpec, ok := spec.(*ast.TypeSpec); ok {
 			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
 				structNode := i.newStruct(structType, genDecl, typeSpec)
+				i.declare(structNode)
 				if i.isNodeMatch(structNode) {
 					i.appendNode(structNode)
 				}
@@ -140,42 +430,1081 @@ func (i *structInspector) inspector(node ast.Node) bool {
 	return true
 }
 
+// interfaceInspector inspects interface declarations.
+type interfaceInspector struct {
+	Nodes  []*InterfaceNode
+	Config InterfaceConfig
+	Base   baseInspector
+
+	patterns namePatterns
+}
+
+// isNodeMatch determines whether an InterfaceNode matches the interface inspection configuration.
+func (i interfaceInspector) isNodeMatch(node *InterfaceNode) bool {
+	nameEquals := i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, node.Node.Name)
+	matchMethods := astMatch(
+		i.Config.Methods, node.Methods(), i.Config.Exact, i.Config.NoMethods, methodSignaturesMatch(i.Config.Exact),
+	)
+	matchEmbeds := astMatch(i.Config.Embeds, node.Embeds(), i.Config.Exact, i.Config.NoEmbeds, returnMatch)
+	matchTypeSet := astMatch(i.Config.TypeSet, node.TypeSet(), i.Config.Exact, nil, returnMatch)
+	return nameEquals && matchMethods.validate() && matchEmbeds.validate() && matchTypeSet.validate()
+}
+
+// appendNode stores a matched InterfaceNode.
+func (i *interfaceInspector) appendNode(node *InterfaceNode) { i.Nodes = append(i.Nodes, node) }
+
+// inspect parses and traverses each file to find matching interface declarations.
+func (i *interfaceInspector) inspect(ctx context.Context) error {
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		fileInspect := interfaceInspector{Config: i.Config, Base: base, patterns: i.patterns}
+		base.inspect(node, []func(n ast.Node) bool{fileInspect.inspector})
+		return func() { i.Nodes = append(i.Nodes, fileInspect.Nodes...) }
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+	return i.Base.partialErr()
+}
+
+// getNodes returns all matched InterfaceNode instances in the configured sort order.
+func (i interfaceInspector) getNodes() []*InterfaceNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *InterfaceNode) BaseNode { return node.Node })
+}
+
+// newInterface constructs an InterfaceNode from its AST components.
+func (i interfaceInspector) newInterface(node ast.Node, gen *ast.GenDecl, spec *ast.TypeSpec) *InterfaceNode {
+	var comment string = ""
+	if gen.Doc != nil {
+		comment = gen.Doc.Text()
+	}
+	baseNode := i.Base.newNode(spec.Name.Name, node, comment)
+	interfaceNode := node.(*ast.InterfaceType)
+	i.Base.setTypeRanges(&baseNode, gen, spec, interfaceNode.Methods.Pos(), interfaceNode.Methods.End())
+	return &InterfaceNode{
+		Node: baseNode, node: interfaceNode, spec: spec, genNode: gen, fset: i.Base.Fset,
+	}
+}
+
+// inspector checks if the current AST node is an interface declaration, then stores it if matched.
+func (i *interfaceInspector) inspector(node ast.Node) bool {
+	genDecl, ok := node.(*ast.GenDecl)
+	if !ok {
+		return true
+	}
+
+	for _, spec := range genDecl.Specs {
+		if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+			if interfaceType, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+				interfaceNode := i.newInterface(interfaceType, genDecl, typeSpec)
+				if i.isNodeMatch(interfaceNode) {
+					i.appendNode(interfaceNode)
+				}
+			}
+		}
+	}
+	return true
+}
+
+// implementsInspector inspects named types and collects those whose method sets satisfy an interface.
+type implementsInspector struct {
+	Nodes  []*ImplementsNode
+	Config ImplementsConfig
+	Base   baseInspector
+
+	candidates []*ImplementsNode
+	interfaces map[string]*InterfaceNode
+	imports    map[string]map[string]string
+	imported   map[string]types.Type
+	required   map[string]string
+}
+
+// isNodeMatch determines whether the method set of T or *T contains every required method.
+func (i implementsInspector) isNodeMatch(node *ImplementsNode) bool {
+	node.Interface = i.Config.Interface
+	for _, pointer := range []bool{false, true} {
+		if methodSetSatisfies(node.methodSet(pointer), i.required) {
+			node.PointerReceiver = pointer
+			return true
+		}
+	}
+	return false
+}
+
+// methodSetSatisfies returns true if every required method is in the method set with the same signature.
+func methodSetSatisfies(methodSet map[string]string, required map[string]string) bool {
+	for name, signature := range required {
+		if methodSignature, ok := methodSet[name]; !ok || methodSignature != signature {
+			return false
+		}
+	}
+	return true
+}
+
+// appendNode stores a matched ImplementsNode.
+func (i *implementsInspector) appendNode(node *ImplementsNode) { i.Nodes = append(i.Nodes, node) }
+
+// inspect collects named types, interfaces and methods, then checks each type's method set.
+func (i *implementsInspector) inspect(ctx context.Context) error {
+	i.interfaces = make(map[string]*InterfaceNode)
+	i.imports = make(map[string]map[string]string)
+	generics := newGenericTypes()
+	methodNodes := make([]*MethodNode, 0)
+	interfaceNodes := make([]*InterfaceNode, 0)
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		fileInspect := implementsInspector{Config: i.Config, Base: base}
+		methodsInspect := methodInspector{Base: base, generics: generics}
+		interfacesInspect := interfaceInspector{Base: base}
+		base.inspect(node, []func(n ast.Node) bool{fileInspect.inspector, methodsInspect.inspector, interfacesInspect.inspector})
+		return func() {
+			i.imports[base.Path] = fileImports(node)
+			i.candidates = append(i.candidates, fileInspect.candidates...)
+			methodNodes = append(methodNodes, methodsInspect.Nodes...)
+			interfaceNodes = append(interfaceNodes, interfacesInspect.Nodes...)
+		}
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+
+	for _, interfaceNode := range interfaceNodes {
+		i.interfaces[structKey(interfaceNode.Node.Path, interfaceNode.Node.Name)] = interfaceNode
+	}
+	required, err := i.requiredMethods()
+	if err != nil {
+		return err
+	}
+	i.required = required
+
+	methods := make(map[string][]*MethodNode)
+	for _, methodNode := range methodNodes {
+		key := structKey(methodNode.Node.Path, methodNode.ReceiverType())
+		methods[key] = append(methods[key], methodNode)
+	}
+	declared := make(map[string]*ImplementsNode, len(i.candidates))
+	for _, candidate := range i.candidates {
+		key := structKey(candidate.Node.Path, candidate.Node.Name)
+		candidate.Methods = methods[key]
+		if _, ok := declared[key]; !ok {
+			declared[key] = candidate
+		}
+	}
+	for _, candidate := range i.candidates {
+		candidate.promoted = i.promotedMethods(candidate, declared)
+		if i.isNodeMatch(candidate) {
+			i.appendNode(candidate)
+		}
+	}
+	return i.Base.partialErr()
+}
+
+// embeddingStruct is a struct reached through embedded fields, and whether an embedded pointer
+// was passed through to reach it.
+type embeddingStruct struct {
+	node    *ImplementsNode
+	pointer bool
+}
+
+// promotedMethods computes the methods promoted to a struct from its embedded fields, searching
+// breadth-first so that shallower members shadow deeper ones and members declared more than
+// once at the same depth are left out as ambiguous. Embedded types are resolved from the
+// scouted code, or imported when qualified by a package, in which case their own promoted
+// methods are taken from go/types.
+func (i *implementsInspector) promotedMethods(node *ImplementsNode, declared map[string]*ImplementsNode) map[string]promotedMethod {
+	promoted := make(map[string]promotedMethod)
+	shadowed := make(map[string]bool)
+	for _, method := range node.Methods {
+		shadowed[method.Name()] = true
+	}
+	if structType, ok := node.spec.Type.(*ast.StructType); ok {
+		for _, field := range structFieldsToNamedTypes(structType.Fields, node.fset) {
+			shadowed[field.Name] = true
+		}
+	}
+
+	visited := map[*ImplementsNode]bool{node: true}
+	level := []embeddingStruct{{node: node}}
+	for len(level) > 0 {
+		var next []embeddingStruct
+		counts := make(map[string]int)
+		methods := make(map[string]promotedMethod)
+		addMethod := func(name string, method promotedMethod) {
+			counts[name]++
+			methods[name] = method
+		}
+
+		for _, outer := range level {
+			structType, ok := outer.node.spec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range structType.Fields.List {
+				if len(field.Names) > 0 {
+					continue
+				}
+				expr, pointer := field.Type, outer.pointer
+				if star, isStar := expr.(*ast.StarExpr); isStar {
+					expr, pointer = star.X, true
+				}
+
+				switch expr := expr.(type) {
+				case *ast.Ident:
+					key := structKey(outer.node.Node.Path, expr.Name)
+					if embedded, isDeclared := declared[key]; isDeclared {
+						for _, method := range embedded.Methods {
+							addMethod(method.Name(), promotedMethod{
+								signature:   pkgutils.FuncTypeString(embedded.fset, method.CallableOps.node.Type),
+								pointerOnly: method.HasPointerReceiver() && !pointer,
+							})
+						}
+						if embeddedStruct, isStruct := embedded.spec.Type.(*ast.StructType); isStruct {
+							for _, embeddedField := range structFieldsToNamedTypes(embeddedStruct.Fields, embedded.fset) {
+								counts[embeddedField.Name]++
+							}
+						}
+						if !visited[embedded] {
+							visited[embedded] = true
+							next = append(next, embeddingStruct{node: embedded, pointer: pointer})
+						}
+					} else if _, isInterface := i.interfaces[key]; isInterface {
+						interfaceMethods, err := i.localInterfaceMethods(key, map[string]bool{})
+						if err != nil {
+							continue
+						}
+						for name, signature := range interfaceMethods {
+							addMethod(name, promotedMethod{signature: signature})
+						}
+					}
+				case *ast.SelectorExpr:
+					pkgIdent, isIdent := expr.X.(*ast.Ident)
+					if !isIdent {
+						continue
+					}
+					pkgPath, isImported := i.imports[outer.node.Node.Path][pkgIdent.Name]
+					if !isImported {
+						continue
+					}
+					typ := i.importType(pkgPath, expr.Sel.Name)
+					if typ == nil {
+						continue
+					}
+					valueSet := types.NewMethodSet(typ)
+					methodSet := valueSet
+					if !types.IsInterface(typ) {
+						methodSet = types.NewMethodSet(types.NewPointer(typ))
+					}
+					for idx := 0; idx < methodSet.Len(); idx++ {
+						method := methodSet.At(idx).Obj()
+						addMethod(method.Name(), promotedMethod{
+							signature:   pkgutils.SignatureString(method.Type().(*types.Signature)),
+							pointerOnly: !pointer && valueSet.Lookup(method.Pkg(), method.Name()) == nil,
+						})
+					}
+					if embeddedStruct, isStruct := typ.Underlying().(*types.Struct); isStruct {
+						for idx := 0; idx < embeddedStruct.NumFields(); idx++ {
+							counts[embeddedStruct.Field(idx).Name()]++
+						}
+					}
+				}
+			}
+		}
+
+		for name, method := range methods {
+			if !shadowed[name] && counts[name] == 1 {
+				promoted[name] = method
+			}
+		}
+		for name := range counts {
+			shadowed[name] = true
+		}
+		level = next
+	}
+	return promoted
+}
+
+// importType imports a type declared in another package, caching it for the scout. Types that
+// cannot be imported, such as those behind an import that is not available offline, are nil.
+func (i *implementsInspector) importType(pkgPath string, name string) types.Type {
+	key := pkgPath + "." + name
+	if typ, ok := i.imported[key]; ok {
+		return typ
+	}
+	if i.imported == nil {
+		i.imported = make(map[string]types.Type)
+	}
+	typ, err := pkgutils.ImportType(pkgPath, name)
+	if err != nil {
+		typ = nil
+	}
+	i.imported[key] = typ
+	return typ
+}
+
+// requiredMethods resolves the configured interface into the signatures of its full method set.
+func (i implementsInspector) requiredMethods() (map[string]string, error) {
+	name := i.Config.Interface
+	if dotIdx := strings.LastIndex(name, "."); dotIdx != -1 {
+		return importedInterfaceMethods(name[:dotIdx], name[dotIdx+1:])
+	}
+
+	keys := make([]string, 0, len(i.interfaces))
+	for key, interfaceNode := range i.interfaces {
+		if interfaceNode.Node.Name == name {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("interface %s was not found", name)
+	}
+	sort.Strings(keys)
+	return i.localInterfaceMethods(keys[0], map[string]bool{})
+}
+
+// localInterfaceMethods returns the method set of a scouted interface, including embedded interfaces.
+func (i implementsInspector) localInterfaceMethods(key string, seen map[string]bool) (map[string]string, error) {
+	interfaceNode := i.interfaces[key]
+	methods := interfaceNode.methodTypes()
+	seen[key] = true
+
+	for _, embed := range interfaceNode.Embeds() {
+		var embedded map[string]string
+		var err error
+		embedKey := structKey(interfaceNode.Node.Path, embed)
+		if _, isLocal := i.interfaces[embedKey]; isLocal {
+			if seen[embedKey] {
+				continue
+			}
+			embedded, err = i.localInterfaceMethods(embedKey, seen)
+		} else if embed == "error" {
+			embedded = map[string]string{"Error": "() (string)"}
+		} else if dotIdx := strings.Index(embed, "."); dotIdx != -1 {
+			pkgPath, ok := i.imports[interfaceNode.Node.Path][embed[:dotIdx]]
+			if !ok {
+				return nil, fmt.Errorf("import for embedded interface %s was not found", embed)
+			}
+			embedded, err = importedInterfaceMethods(pkgPath, embed[dotIdx+1:])
+		}
+		if err != nil {
+			return nil, err
+		}
+		for name, signature := range embedded {
+			methods[name] = signature
+		}
+	}
+	return methods, nil
+}
+
+// importedInterfaceMethods returns the method set of an interface declared in an importable package.
+func importedInterfaceMethods(pkgPath string, name string) (map[string]string, error) {
+	iface, err := pkgutils.ImportInterface(pkgPath, name)
+	if err != nil {
+		return nil, err
+	}
+	methods := make(map[string]string)
+	for idx := 0; idx < iface.NumMethods(); idx++ {
+		method := iface.Method(idx)
+		methods[method.Name()] = pkgutils.SignatureString(method.Type().(*types.Signature))
+	}
+	return methods, nil
+}
+
+// fileImports maps the name each import is referenced by in the file to its import path.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+	if file == nil {
+		return imports
+	}
+	for _, importSpec := range file.Imports {
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := filepath.Base(importPath)
+		if importSpec.Name != nil {
+			name = importSpec.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// getNodes returns all matched ImplementsNode instances in the configured sort order.
+func (i implementsInspector) getNodes() []*ImplementsNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *ImplementsNode) BaseNode { return node.Node })
+}
+
+// newImplements constructs a candidate ImplementsNode from its AST components.
+func (i implementsInspector) newImplements(gen *ast.GenDecl, spec *ast.TypeSpec) *ImplementsNode {
+	var comment string = ""
+	if gen.Doc != nil {
+		comment = gen.Doc.Text()
+	}
+	baseNode := i.Base.newNode(spec.Name.Name, spec.Type, comment)
+	i.Base.setTypeRanges(&baseNode, gen, spec, spec.Type.Pos(), spec.Type.End())
+	return &ImplementsNode{Node: baseNode, spec: spec, genNode: gen, fset: i.Base.Fset}
+}
+
+// inspector collects every named non-interface type as a candidate implementation.
+func (i *implementsInspector) inspector(node ast.Node) bool {
+	genDecl, ok := node.(*ast.GenDecl)
+	if !ok {
+		return true
+	}
+
+	for _, spec := range genDecl.Specs {
+		if typeSpec, ok := spec.(*ast.TypeSpec); ok && !typeSpec.Assign.IsValid() {
+			if _, isInterface := typeSpec.Type.(*ast.InterfaceType); !isInterface {
+				i.candidates = append(i.candidates, i.newImplements(genDecl, typeSpec))
+			}
+		}
+	}
+	return true
+}
+
+// callGraphInspector inspects function and method bodies and collects every resolved call.
+type callGraphInspector struct {
+	Nodes  []*CallEdge
+	Config CallGraphConfig
+	Base   baseInspector
+
+	candidates  []*CallEdge
+	packages    map[string]bool
+	packagePath string
+	info        *types.Info
+}
+
+// isNodeMatch determines whether a call is kept, dropping calls into imported packages
+// when only local calls are requested.
+func (i callGraphInspector) isNodeMatch(node *CallEdge) bool {
+	return !i.Config.LocalOnly || i.packages[node.calleePkg]
+}
+
+// appendNode stores a matched CallEdge.
+func (i *callGraphInspector) appendNode(node *CallEdge) { i.Nodes = append(i.Nodes, node) }
+
+// inspect collects the calls made in every file, then keeps the matching calls.
+func (i *callGraphInspector) inspect(ctx context.Context) error {
+	i.packages = make(map[string]bool)
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		if node == nil {
+			return func() {}
+		}
+		fileInspect := callGraphInspector{Config: i.Config, Base: base}
+		fileInspect.packagePath = packagePath(filepath.Dir(base.Path), node.Name.Name)
+		if checked := base.Types.packageOf(base.Path); checked != nil {
+			fileInspect.packagePath = checked.pkg.Path()
+			fileInspect.info = checked.info
+		}
+		base.inspect(node, []func(n ast.Node) bool{fileInspect.inspector})
+		return func() {
+			i.packages[fileInspect.packagePath] = true
+			i.candidates = append(i.candidates, fileInspect.candidates...)
+		}
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+
+	for _, candidate := range i.candidates {
+		if i.isNodeMatch(candidate) {
+			i.appendNode(candidate)
+		}
+	}
+	return i.Base.partialErr()
+}
+
+// getNodes returns all matched CallEdges.
+func (i callGraphInspector) getNodes() []*CallEdge { return i.Nodes }
+
+// inspector records the resolved calls in the body of each function and method declaration,
+// including calls made inside function literals, which are attributed to the declaration.
+func (i *callGraphInspector) inspector(node ast.Node) bool {
+	funcDecl, ok := node.(*ast.FuncDecl)
+	if !ok {
+		return true
+	}
+
+	caller := declSymbol(i.packagePath, funcDecl)
+	if funcDecl.Body == nil || i.info == nil {
+		return true
+	}
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if fn := calledFunc(i.info, call.Fun); fn != nil {
+			line, column := i.Base.getPos(call)
+			i.candidates = append(i.candidates, &CallEdge{
+				Caller: caller, Callee: funcSymbol(fn), Path: i.Base.Path, Line: line, Column: column,
+				calleePkg: calledPackage(fn),
+			})
+		}
+		return true
+	})
+	return true
+}
+
+// referencesInspector inspects every identifier and collects the uses of a symbol.
+type referencesInspector struct {
+	Nodes  []*Reference
+	Target Symbol
+	Base   baseInspector
+
+	target      token.Position
+	resolved    bool
+	targetPkg   string
+	file        *ast.File
+	info        *types.Info
+	packageName string
+	imports     map[string]string
+	declared    map[*ast.Ident]bool
+	selectors   map[*ast.Ident]*ast.SelectorExpr
+}
+
+// isNodeMatch always keeps a reference, as only uses of the symbol are collected.
+func (i referencesInspector) isNodeMatch(node *Reference) bool { return true }
+
+// appendNode stores a matched Reference.
+func (i *referencesInspector) appendNode(node *Reference) { i.Nodes = append(i.Nodes, node) }
+
+// inspect resolves the symbol in its own package, then collects its uses in every file.
+func (i *referencesInspector) inspect(ctx context.Context) error {
+	i.resolveTarget()
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		if node == nil {
+			return func() {}
+		}
+
+		fileInspect := *i
+		fileInspect.Nodes = nil
+		fileInspect.Base = base
+		fileInspect.file = node
+		fileInspect.packageName = node.Name.Name
+		fileInspect.imports = fileImports(node)
+		fileInspect.selectors = make(map[*ast.Ident]*ast.SelectorExpr)
+		fileInspect.info = nil
+		if checked := base.Types.packageOf(base.Path); checked != nil {
+			fileInspect.info = checked.info
+		}
+		fileInspect.declared = declarationIdents(node)
+		base.inspect(node, []func(n ast.Node) bool{fileInspect.inspector})
+		return func() { i.Nodes = append(i.Nodes, fileInspect.Nodes...) }
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+	return i.Base.partialErr()
+}
+
+// resolveTarget type-checks the package declaring the symbol and records the position of its
+// declaration. When the symbol cannot be resolved, every use is matched syntactically.
+func (i *referencesInspector) resolveTarget() {
+	node, _ := i.Base.Types.parseFile(i.Base, i.Target.Path)
+	if node == nil {
+		return
+	}
+	i.targetPkg = node.Name.Name
+	checked := i.Base.Types.packageOf(i.Target.Path)
+	if checked == nil {
+		return
+	}
+	if obj := symbolObject(checked.pkg, i.Target); obj != nil && obj.Pos().IsValid() {
+		i.target = declarationKey(i.Base.Fset.Position(obj.Pos()))
+		i.resolved = true
+	}
+}
+
+// getNodes returns all matched References.
+func (i referencesInspector) getNodes() []*Reference { return i.Nodes }
+
+// inspector checks each identifier against the symbol, using the checked object it refers to
+// when there is one and its name and qualifier otherwise.
+func (i *referencesInspector) inspector(node ast.Node) bool {
+	if selector, ok := node.(*ast.SelectorExpr); ok {
+		i.selectors[selector.Sel] = selector
+		return true
+	}
+	ident, ok := node.(*ast.Ident)
+	if !ok || ident.Name != i.Target.Name || i.declared[ident] {
+		return true
+	}
+
+	syntactic := true
+	if i.info != nil {
+		if obj, ok := i.info.Uses[ident]; ok && i.resolved {
+			if declarationKey(i.Base.Fset.Position(obj.Pos())) != i.target {
+				return true
+			}
+			syntactic = false
+		} else if _, ok := i.info.Defs[ident]; ok {
+			return true
+		}
+	}
+	if syntactic && !i.syntacticMatch(ident) {
+		return true
+	}
+
+	line, column := i.Base.getPos(ident)
+	i.appendNode(&Reference{
+		Name:      ident.Name,
+		Path:      i.Base.Path,
+		Line:      line,
+		Column:    column,
+		Enclosing: enclosingDecl(i.packageName, i.file, ident.Pos()),
+		Syntactic: syntactic,
+	})
+	return true
+}
+
+// syntacticMatch determines whether an identifier with the symbol's name refers to it, going by
+// the package it is written in or qualified with. Methods and fields match any selector.
+func (i referencesInspector) syntacticMatch(ident *ast.Ident) bool {
+	selector, isSelected := i.selectors[ident]
+	if i.Target.Kind == MethodSymbol || i.Target.Kind == FieldSymbol {
+		return isSelected
+	}
+	if !isSelected {
+		return i.packageName == i.targetPkg
+	}
+	qualifier, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, imported := i.imports[qualifier.Name]
+	return imported && qualifier.Name == i.targetPkg
+}
+
+// typeInspector inspects every declared type and associates its methods.
+type typeInspector struct {
+	Nodes  []*TypeNode
+	Config TypeConfig
+	Base   baseInspector
+
+	candidates []*TypeNode
+	patterns   namePatterns
+}
+
+// isNodeMatch determines whether a TypeNode matches the type inspection configuration.
+func (i typeInspector) isNodeMatch(node *TypeNode) bool {
+	nameEquals := i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, node.Node.Name)
+	kindEquals := !(i.Config.Kind != "" && i.Config.Kind != node.Kind())
+	underlyingEquals := !(i.Config.Underlying != "" && i.Config.Underlying != node.Underlying())
+	matchMethods := astMatch(i.Config.Methods, node.MethodNames(), i.Config.Exact, i.Config.NoMethods, returnMatch)
+	return nameEquals && kindEquals && underlyingEquals && matchMethods.validate()
+}
+
+// appendNode stores a matched TypeNode.
+func (i *typeInspector) appendNode(node *TypeNode) { i.Nodes = append(i.Nodes, node) }
+
+// inspect collects every declared type, attaches its methods and keeps the matching types.
+func (i *typeInspector) inspect(ctx context.Context) error {
+	generics := newGenericTypes()
+	methodNodes := make([]*MethodNode, 0)
+
+	// Only package-level declarations are visited, so types declared in function bodies,
+	// which cannot have methods, are not reported.
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		fileInspect := typeInspector{Config: i.Config, Base: base, patterns: i.patterns}
+		methodsInspect := methodInspector{Base: base, generics: generics}
+		if node != nil {
+			for _, decl := range node.Decls {
+				fileInspect.inspector(decl)
+				methodsInspect.inspector(decl)
+			}
+		}
+		return func() {
+			i.candidates = append(i.candidates, fileInspect.candidates...)
+			methodNodes = append(methodNodes, methodsInspect.Nodes...)
+		}
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+
+	methods := make(map[string][]*MethodNode)
+	for _, methodNode := range methodNodes {
+		key := structKey(methodNode.Node.Path, methodNode.ReceiverType())
+		methods[key] = append(methods[key], methodNode)
+	}
+	for _, candidate := range i.candidates {
+		if !candidate.IsAlias() {
+			candidate.Methods = methods[structKey(candidate.Node.Path, candidate.Node.Name)]
+		}
+		if i.isNodeMatch(candidate) {
+			i.appendNode(candidate)
+		}
+	}
+	return i.Base.partialErr()
+}
+
+// getNodes returns all matched TypeNode instances in the configured sort order.
+func (i typeInspector) getNodes() []*TypeNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *TypeNode) BaseNode { return node.Node })
+}
+
+// newType constructs a TypeNode from its AST components.
+func (i typeInspector) newType(gen *ast.GenDecl, spec *ast.TypeSpec) *TypeNode {
+	comment := commentText(spec.Doc, spec.Comment)
+	if comment == "" {
+		comment = commentText(gen.Doc, nil)
+	}
+	baseNode := i.Base.newNode(spec.Name.Name, spec, comment)
+	i.Base.setTypeRanges(&baseNode, gen, spec, spec.Type.Pos(), spec.Type.End())
+	return &TypeNode{Node: baseNode, spec: spec, genNode: gen, fset: i.Base.Fset}
+}
+
+// inspector collects every type declared by a package-level declaration as a candidate.
+func (i *typeInspector) inspector(node ast.Node) bool {
+	genDecl, ok := node.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.TYPE {
+		return true
+	}
+
+	for _, spec := range genDecl.Specs {
+		if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+			i.candidates = append(i.candidates, i.newType(genDecl, typeSpec))
+		}
+	}
+	return true
+}
+
+// commentText returns the trimmed text of the doc comment, falling back to the trailing comment.
+func commentText(doc *ast.CommentGroup, trailing *ast.CommentGroup) string {
+	if doc != nil {
+		return strings.TrimSpace(doc.Text())
+	}
+	if trailing != nil {
+		return strings.TrimSpace(trailing.Text())
+	}
+	return ""
+}
+
+// hasIota reports whether any of the expressions reference iota.
+func hasIota(exprs []ast.Expr) bool {
+	found := false
+	for _, expr := range exprs {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && ident.Name == "iota" {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// constInspector inspects package-level constant declarations, grouping iota blocks into enums.
+type constInspector struct {
+	Nodes  []*ConstNode
+	Config ConstConfig
+	Base   baseInspector
+
+	// known holds the evaluated constants of each package, keyed by directory.
+	known    map[string]map[string]constant.Value
+	patterns namePatterns
+}
+
+// isNodeMatch determines whether a ConstNode matches the constant inspection configuration.
+func (i constInspector) isNodeMatch(node *ConstNode) bool {
+	nameEquals := i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, node.Node.Name)
+	for _, member := range node.Members {
+		nameEquals = nameEquals || i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, member.Name)
+	}
+	typeEquals := !(i.Config.Type != "" && i.Config.Type != node.Type())
+	validExported := i.Config.Exported == nil || *i.Config.Exported == node.Node.Exported
+	validValue := i.Config.HasValue == nil || *i.Config.HasValue == node.HasValue()
+	validEnum := i.Config.IsEnum == nil || *i.Config.IsEnum == node.IsEnum()
+	return nameEquals && typeEquals && validExported && validValue && validEnum
+}
+
+// appendNode stores a matched ConstNode.
+func (i *constInspector) appendNode(node *ConstNode) { i.Nodes = append(i.Nodes, node) }
+
+// inspect parses each file and inspects its package-level declarations.
+func (i *constInspector) inspect(ctx context.Context) error {
+	i.known = make(map[string]map[string]constant.Value)
+	// Constants may refer to the constants of files merged before theirs, so they are
+	// evaluated as each file is merged rather than by the workers.
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		return func() {
+			if node == nil {
+				return
+			}
+			for _, decl := range node.Decls {
+				if i.Base.stopped() {
+					return
+				}
+				i.inspector(decl)
+			}
+		}
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+	return i.Base.partialErr()
+}
+
+// getNodes returns all matched ConstNode instances in the configured sort order.
+func (i constInspector) getNodes() []*ConstNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *ConstNode) BaseNode { return node.Node })
+}
+
+// evaluateSpecs resolves every constant in the block, carrying implicitly repeated types and
+// values forward and evaluating each one with its iota.
+func (i *constInspector) evaluateSpecs(gen *ast.GenDecl) [][]ConstValue {
+	dir := filepath.Dir(i.Base.Path)
+	known, ok := i.known[dir]
+	if !ok {
+		known = make(map[string]constant.Value)
+		i.known[dir] = known
+	}
+
+	var prevType ast.Expr
+	var prevValues []ast.Expr
+	specValues := make([][]ConstValue, 0, len(gen.Specs))
+	for iota, spec := range gen.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		explicit := len(valueSpec.Values) > 0
+		if explicit {
+			prevType, prevValues = valueSpec.Type, valueSpec.Values
+		}
+
+		members := make([]ConstValue, 0, len(valueSpec.Names))
+		for idx, name := range valueSpec.Names {
+			member := ConstValue{Name: name.Name, Explicit: explicit, Comment: commentText(valueSpec.Doc, valueSpec.Comment)}
+			if prevType != nil {
+				member.Type = pkgutils.NodeToCode(i.Base.Fset, prevType)
+			}
+			if idx < len(prevValues) {
+				member.Value = pkgutils.NodeToCode(i.Base.Fset, prevValues[idx])
+				if value := pkgutils.EvalConstExpr(prevValues[idx], int64(iota), known); value != nil {
+					if name.Name != "_" {
+						known[name.Name] = value
+					}
+					member.Evaluated = value.ExactString()
+				}
+			}
+			members = append(members, member)
+		}
+		specValues = append(specValues, members)
+	}
+	return specValues
+}
+
+// newConst constructs a ConstNode positioned at the given node.
+func (i constInspector) newConst(
+	name string, node ast.Node, comment string, gen *ast.GenDecl, specs []*ast.ValueSpec, members []ConstValue, isEnum bool,
+) *ConstNode {
+	baseNode := i.Base.newNode(name, node, comment)
+	if isEnum {
+		baseNode.Range = i.Base.span(gen.Pos(), gen.End())
+		baseNode.DocRange = i.Base.docSpan(gen.Doc)
+	} else {
+		i.Base.setValueRanges(&baseNode, gen, specs[0], specs[0].Doc)
+	}
+	return &ConstNode{
+		Node: baseNode, Members: members, isEnum: isEnum, specs: specs, genNode: gen, fset: i.Base.Fset,
+	}
+}
+
+// inspector checks if the AST node is a constant declaration, then stores matching constants or enums.
+func (i *constInspector) inspector(node ast.Node) bool {
+	genDecl, ok := node.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.CONST {
+		return true
+	}
+
+	specs := make([]*ast.ValueSpec, 0, len(genDecl.Specs))
+	isEnum := false
+	for _, spec := range genDecl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		specs = append(specs, valueSpec)
+		isEnum = isEnum || hasIota(valueSpec.Values)
+	}
+	specValues := i.evaluateSpecs(genDecl)
+
+	if isEnum {
+		var members []ConstValue
+		for _, values := range specValues {
+			members = append(members, values...)
+		}
+		name := firstNamedMember(members)
+		if enumType := sharedConstType(members); enumType != "" {
+			name = enumType
+		}
+		enumNode := i.newConst(name, genDecl, commentText(genDecl.Doc, nil), genDecl, specs, members, true)
+		if i.isNodeMatch(enumNode) {
+			i.appendNode(enumNode)
+		}
+		return true
+	}
+
+	for specIdx, valueSpec := range specs {
+		comment := commentText(valueSpec.Doc, valueSpec.Comment)
+		if comment == "" && !genDecl.Lparen.IsValid() {
+			comment = commentText(genDecl.Doc, nil)
+		}
+		for idx, name := range valueSpec.Names {
+			member := specValues[specIdx][idx]
+			constNode := i.newConst(name.Name, name, comment, genDecl, []*ast.ValueSpec{valueSpec}, []ConstValue{member}, false)
+			if i.isNodeMatch(constNode) {
+				i.appendNode(constNode)
+			}
+		}
+	}
+	return true
+}
+
+// varInspector inspects package-level variable declarations.
+type varInspector struct {
+	Nodes  []*VarNode
+	Config VarConfig
+	Base   baseInspector
+
+	patterns namePatterns
+}
+
+// isNodeMatch determines whether a VarNode matches the variable inspection configuration.
+func (i varInspector) isNodeMatch(node *VarNode) bool {
+	nameEquals := i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, node.Node.Name)
+	typeEquals := !(i.Config.Type != "" && i.Config.Type != node.Type())
+	validExported := i.Config.Exported == nil || *i.Config.Exported == node.Node.Exported
+	validValue := i.Config.HasValue == nil || *i.Config.HasValue == node.HasValue()
+	return nameEquals && typeEquals && validExported && validValue
+}
+
+// appendNode stores a matched VarNode.
+func (i *varInspector) appendNode(node *VarNode) { i.Nodes = append(i.Nodes, node) }
+
+// inspect parses each file and inspects its package-level declarations.
+func (i *varInspector) inspect(ctx context.Context) error {
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		fileInspect := varInspector{Config: i.Config, Base: base, patterns: i.patterns}
+		if node != nil {
+			for _, decl := range node.Decls {
+				fileInspect.inspector(decl)
+			}
+		}
+		return func() { i.Nodes = append(i.Nodes, fileInspect.Nodes...) }
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+	return i.Base.partialErr()
+}
+
+// getNodes returns all matched VarNode instances in the configured sort order.
+func (i varInspector) getNodes() []*VarNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *VarNode) BaseNode { return node.Node })
+}
+
+// newVar constructs a VarNode for the name at index within the value spec.
+func (i varInspector) newVar(gen *ast.GenDecl, spec *ast.ValueSpec, index int) *VarNode {
+	comment := commentText(spec.Doc, spec.Comment)
+	if comment == "" && !gen.Lparen.IsValid() {
+		comment = commentText(gen.Doc, nil)
+	}
+	name := spec.Names[index]
+	baseNode := i.Base.newNode(name.Name, name, comment)
+	i.Base.setValueRanges(&baseNode, gen, spec, spec.Doc)
+	return &VarNode{Node: baseNode, index: index, spec: spec, genNode: gen, fset: i.Base.Fset}
+}
+
+// inspector checks if the AST node is a variable declaration, then stores matching variables.
+func (i *varInspector) inspector(node ast.Node) bool {
+	genDecl, ok := node.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.VAR {
+		return true
+	}
+
+	for _, spec := range genDecl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		for idx := range valueSpec.Names {
+			varNode := i.newVar(genDecl, valueSpec, idx)
+			if i.isNodeMatch(varNode) {
+				i.appendNode(varNode)
+			}
+		}
+	}
+	return true
+}
+
 // methodInspector inspects methods and captures metadata such as accessed fields and called methods.
 type methodInspector struct {
 	Nodes  []*MethodNode
 	Config MethodConfig
 	Base   baseInspector
+
+	generics *genericTypes
+	patterns namePatterns
+}
+
+// genericTypes holds the type parameters of the generic types declared in each package, keyed
+// by directory and type name, to resolve the constraints of generic receivers. It is shared by
+// the files inspected concurrently.
+type genericTypes struct {
+	mu         sync.Mutex
+	typeParams map[string]map[string][]NamedType
+	// loaded records the packages whose files were all read for their generic types.
+	loaded map[string]bool
+}
+
+// newGenericTypes returns an empty genericTypes.
+func newGenericTypes() *genericTypes {
+	return &genericTypes{typeParams: make(map[string]map[string][]NamedType), loaded: make(map[string]bool)}
 }
 
 // isNodeMatch determines whether a MethodNode matches method inspection criteria.
 func (i methodInspector) isNodeMatch(node *MethodNode) bool {
-	nameEquals := !(i.Config.Name != "" && i.Config.Name != node.Node.Name)
-	matchReturn := astMatch(i.Config.ReturnTypes, node.CallableOps.ReturnTypes(), i.Config.Exact, i.Config.NoReturn, returnMatch)
-	matchParams := astMatch(i.Config.ParamTypes, node.CallableOps.Parameters(), i.Config.Exact, i.Config.NoParams, namedTypesMatch)
-	validReceiver := !(i.Config.Receiver != "" && i.Config.Receiver != node.ReceiverType())
+	nameEquals := i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, node.Node.Name)
+	paramsMatch, returnsMatch := i.Base.callableValidators(node.CallableOps.node)
+	matchReturn := astMatch(i.Config.ReturnTypes, node.CallableOps.ReturnTypes(), i.Config.Exact, i.Config.NoReturn, returnsMatch)
+	includedParams := paramsMatch
+	if i.Config.OrderedParams {
+		includedParams = i.Base.orderedParamsValidator(node.CallableOps.node)
+	}
+	matchParams := astMatch(i.Config.ParamTypes, node.CallableOps.Parameters(), i.Config.Exact, i.Config.NoParams, includedParams)
+	excludedReturn := excludesMatch(i.Config.ExcludeReturnTypes, node.CallableOps.ReturnTypes(), returnsMatch)
+	excludedParams := excludesMatch(i.Config.ExcludeParamTypes, node.CallableOps.Parameters(), paramsMatch)
+	validReceiver := i.patterns.nameMatch(i.Config.Receiver, i.Config.ReceiverPattern, i.Config.PatternMode, node.ReceiverType())
 
 	validPtr := i.Config.IsPointerRec == nil || *i.Config.IsPointerRec == node.HasPointerReceiver()
-	return nameEquals && matchReturn.validate() && matchParams.validate() && validReceiver && validPtr
+	matchTypeParams := astMatch(i.Config.TypeParams, node.TypeParams(), i.Config.Exact, i.Config.NoTypeParams, namedTypesMatch)
+	validGeneric := i.Config.IsGeneric == nil || *i.Config.IsGeneric == node.IsGeneric()
+	return nameEquals && matchReturn.validate() && matchParams.validate() && !excludedReturn && !excludedParams &&
+		validReceiver && validPtr && matchTypeParams.validate() && validGeneric
 }
 
 // isAttrsMatch validates the fields accessed and methods called by the method node.
 func (i methodInspector) isAttrsMatch(node *MethodNode) bool {
 	matchAccessed := astMatch(i.Config.Fields, node.FieldsAccessed(), i.Config.Exact, i.Config.NoFields, accessedMatch)
 	matchCalled := astMatch(i.Config.Methods, node.MethodsCalled(), i.Config.Exact, i.Config.NoMethods, accessedMatch)
-	return matchAccessed.validate() && matchCalled.validate()
+	excludedAccessed := excludesMatch(i.Config.ExcludeFields, node.FieldsAccessed(), accessedMatch)
+	excludedCalled := excludesMatch(i.Config.ExcludeMethods, node.MethodsCalled(), accessedMatch)
+	return matchAccessed.validate() && matchCalled.validate() && !excludedAccessed && !excludedCalled
 }
 
 // appendNode stores a matched MethodNode.
 func (i *methodInspector) appendNode(node *MethodNode) { i.Nodes = append(i.Nodes, node) }
 
-// inspect parses and traverses the file to extract method nodes.
-func (i *methodInspector) inspect() {
-	node := pkgutils.ParseFile(i.Base.Path, i.Base.Fset)
-	i.Base.inspect(node, []func(n ast.Node) bool{i.inspector})
+// inspect parses and traverses each file to extract method nodes.
+func (i *methodInspector) inspect(ctx context.Context) error {
+	generics := newGenericTypes()
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		fileInspect := methodInspector{Config: i.Config, Base: base, generics: generics, patterns: i.patterns}
+		base.inspect(node, []func(n ast.Node) bool{fileInspect.inspector})
+		return func() { i.Nodes = append(i.Nodes, fileInspect.Nodes...) }
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+	return i.Base.partialErr()
 }
 
-// getNodes returns all matched MethodNode instances.
-func (i methodInspector) getNodes() []*MethodNode { return i.Nodes }
+// getNodes returns all matched MethodNode instances in the configured sort order.
+func (i methodInspector) getNodes() []*MethodNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *MethodNode) BaseNode { return node.Node })
+}
 
 // newMethod constructs a MethodNode with tracking fields and associated callable operations.
 func (i methodInspector) newMethod(name string, node ast.Node, comment string) *MethodNode {
@@ -188,8 +1517,71 @@ func (i methodInspector) newMethod(name string, node ast.Node, comment string) *
 	}
 }
 
+// declareTypeParams records the type parameters of the generic types declared in the file.
+func (g *genericTypes) declareTypeParams(path string, file *ast.File, fset *token.FileSet) {
+	dir := filepath.Dir(path)
+	if g.typeParams[dir] == nil {
+		g.typeParams[dir] = make(map[string][]NamedType)
+	}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			if typeSpec, ok := spec.(*ast.TypeSpec); ok && typeSpec.TypeParams != nil {
+				g.typeParams[dir][typeSpec.Name.Name] = typeParamsToNamedTypes(typeSpec.TypeParams, fset)
+			}
+		}
+	}
+}
+
+// receiverTypeParams pairs the type parameter names of a generic receiver with the constraints
+// declared by its type, by position. The type is looked up in the files seen so far, and then
+// in every file of its package, which are read once.
+func (i *methodInspector) receiverTypeParams(node *MethodNode) []NamedType {
+	if i.generics == nil {
+		i.generics = newGenericTypes()
+	}
+	i.generics.mu.Lock()
+	defer i.generics.mu.Unlock()
+
+	dir := filepath.Dir(node.Node.Path)
+	declared, ok := i.generics.typeParams[dir][node.ReceiverType()]
+	if !ok && !i.generics.loaded[dir] {
+		i.generics.loaded[dir] = true
+		packagePaths, _ := sourceFor(node.Node.Path, i.Base.Source).packageFiles(node.Node.Path)
+		for _, packagePath := range packagePaths {
+			if file, _ := i.Base.parseSource(packagePath); file != nil {
+				i.generics.declareTypeParams(packagePath, file, i.Base.Fset)
+			}
+		}
+		declared = i.generics.typeParams[dir][node.ReceiverType()]
+	}
+
+	names := node.ReceiverTypeParams()
+	typeParams := make([]NamedType, 0, len(names))
+	for idx, name := range names {
+		typeParam := NamedType{Name: name}
+		if len(declared) == len(names) {
+			typeParam.Type = declared[idx].Type
+		}
+		typeParams = append(typeParams, typeParam)
+	}
+	return typeParams
+}
+
 // inspector traverses AST to identify method declarations and captures method interactions.
 func (i *methodInspector) inspector(n ast.Node) bool {
+	if file, ok := n.(*ast.File); ok {
+		if i.generics == nil {
+			i.generics = newGenericTypes()
+		}
+		i.generics.mu.Lock()
+		i.generics.declareTypeParams(i.Base.Path, file, i.Base.Fset)
+		i.generics.mu.Unlock()
+		return true
+	}
 	funcDecl, ok := n.(*ast.FuncDecl)
 	if !ok || funcDecl.Recv == nil {
 		return true
@@ -201,6 +1593,9 @@ func (i *methodInspector) inspector(n ast.Node) bool {
 	}
 	name := funcDecl.Name.Name
 	methodNode := i.newMethod(name, funcDecl, comment)
+	if methodNode.IsGeneric() {
+		methodNode.typeParams = i.receiverTypeParams(methodNode)
+	}
 	receiverName := methodNode.ReceiverName()
 
 	if i.isNodeMatch(methodNode) {
@@ -238,14 +1633,26 @@ type funcInspector struct {
 	Nodes  []*FuncNode
 	Config FuncConfig
 	Base   baseInspector
+
+	patterns namePatterns
 }
 
 // isNodeMatch determines whether a function matches the criteria defined in FuncConfig.
 func (i funcInspector) isNodeMatch(node *FuncNode) bool {
-	nameEquals := !(i.Config.Name != "" && i.Config.Name != node.Node.Name)
-	matchReturn := astMatch(i.Config.ReturnTypes, node.CallableOps.ReturnTypes(), i.Config.Exact, i.Config.NoReturn, returnMatch)
-	matchParams := astMatch(i.Config.ParamTypes, node.CallableOps.Parameters(), i.Config.Exact, i.Config.NoParams, namedTypesMatch)
-	return nameEquals && matchReturn.validate() && matchParams.validate()
+	nameEquals := i.patterns.nameMatch(i.Config.Name, i.Config.NamePattern, i.Config.PatternMode, node.Node.Name)
+	paramsMatch, returnsMatch := i.Base.callableValidators(node.CallableOps.node)
+	matchReturn := astMatch(i.Config.ReturnTypes, node.CallableOps.ReturnTypes(), i.Config.Exact, i.Config.NoReturn, returnsMatch)
+	includedParams := paramsMatch
+	if i.Config.OrderedParams {
+		includedParams = i.Base.orderedParamsValidator(node.CallableOps.node)
+	}
+	matchParams := astMatch(i.Config.ParamTypes, node.CallableOps.Parameters(), i.Config.Exact, i.Config.NoParams, includedParams)
+	excludedReturn := excludesMatch(i.Config.ExcludeReturnTypes, node.CallableOps.ReturnTypes(), returnsMatch)
+	excludedParams := excludesMatch(i.Config.ExcludeParamTypes, node.CallableOps.Parameters(), paramsMatch)
+	matchTypeParams := astMatch(i.Config.TypeParams, node.CallableOps.TypeParams(), i.Config.Exact, i.Config.NoTypeParams, namedTypesMatch)
+	validGeneric := i.Config.IsGeneric == nil || *i.Config.IsGeneric == node.IsGeneric()
+	return nameEquals && matchReturn.validate() && matchParams.validate() && !excludedReturn && !excludedParams &&
+		matchTypeParams.validate() && validGeneric
 }
 
 // appendNode stores a matched FuncNode.
@@ -253,14 +1660,23 @@ func (i *funcInspector) appendNode(node *FuncNode) {
 	i.Nodes = append(i.Nodes, node)
 }
 
-// inspect parses and traverses the file to find matching function declarations.
-func (i *funcInspector) inspect() {
-	node := pkgutils.ParseFile(i.Base.Path, i.Base.Fset)
-	i.Base.inspect(node, []func(n ast.Node) bool{i.inspector})
+// inspect parses and traverses each file to find matching function declarations.
+func (i *funcInspector) inspect(ctx context.Context) error {
+	scanErr := i.Base.scan(ctx, func(base baseInspector, node *ast.File) func() {
+		fileInspect := funcInspector{Config: i.Config, Base: base, patterns: i.patterns}
+		base.inspect(node, []func(n ast.Node) bool{fileInspect.inspector})
+		return func() { i.Nodes = append(i.Nodes, fileInspect.Nodes...) }
+	})
+	if scanErr != nil {
+		return scanErr
+	}
+	return i.Base.partialErr()
 }
 
-// getNodes returns all matched FuncNode instances.
-func (i funcInspector) getNodes() []*FuncNode { return i.Nodes }
+// getNodes returns all matched FuncNode instances in the configured sort order.
+func (i funcInspector) getNodes() []*FuncNode {
+	return sortNodes(i.Nodes, i.Config.Sort, func(node *FuncNode) BaseNode { return node.Node })
+}
 
 // newFunction constructs a FuncNode from the given AST node.
 func (i funcInspector) newFunction(name string, node ast.Node, comment string) *FuncNode {
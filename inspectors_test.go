@@ -137,10 +137,87 @@ type Person struct {
 
 	noFields := false
 	structConfig := StructConfig{Name: "Person", NoFields: &noFields}
-	si := structInspector{Nodes: map[string]*StructNode{}, Config: structConfig, Base: baseInspector{Path: "p.go", Fset: fset}}
+	si := structInspector{Nodes: []*StructNode{}, Config: structConfig, Base: baseInspector{Path: "p.go", Fset: fset}}
 	sNode := si.newStruct(spec.Type, gen, spec)
 
 	_ = si.inspector(file.Decls[0])
 	assert.Len(t, si.Nodes, 1)
 	assert.True(t, si.isNodeMatch(sNode))
 }
+
+func TestNodeRanges(t *testing.T) {
+	src := `package shapes
+
+// Area returns the area.
+func Area(w, h int) int {
+	return w * h
+}
+
+// Square is a shape.
+type Square struct {
+	Side int
+}
+
+type (
+	// Shape has an area.
+	Shape interface {
+		Area() int
+	}
+	// Sides counts edges.
+	Sides[T any] []T
+)
+
+// Colors of a shape.
+const (
+	Red = iota
+	Blue
+)
+
+var (
+	// Origin is the center.
+	Origin = 0
+)
+`
+	slice := func(r Range) string { return src[r.Start.Offset:r.End.Offset] }
+
+	funcNode, err := ScoutFunctionSource("shapes.go", src, FuncConfig{Name: "Area"})
+	assert.NoError(t, err)
+	assert.Equal(t, "func Area(w, h int) int {\n\treturn w * h\n}", slice(funcNode.Node.Range))
+	assert.Equal(t, "// Area returns the area.", slice(funcNode.Node.DocRange))
+	assert.Equal(t, "func Area(w, h int) int", slice(funcNode.Node.SignatureRange))
+	assert.Equal(t, "{\n\treturn w * h\n}", slice(funcNode.Node.BodyRange))
+	assert.Equal(t, Position{Line: 4, Column: 1, Offset: 42}, funcNode.Node.Range.Start)
+	assert.Equal(t, Position{Line: 6, Column: 2, Offset: 83}, funcNode.Node.Range.End)
+
+	structNode, err := ScoutStructSource("shapes.go", src, StructConfig{Name: "Square"})
+	assert.NoError(t, err)
+	assert.Equal(t, "type Square struct {\n\tSide int\n}", slice(structNode.Node.Range))
+	assert.Equal(t, "// Square is a shape.", slice(structNode.Node.DocRange))
+	assert.Equal(t, "Square", slice(structNode.Node.SignatureRange))
+	assert.Equal(t, "{\n\tSide int\n}", slice(structNode.Node.BodyRange))
+
+	interfaceNode, err := ScoutInterfaceSource("shapes.go", src, InterfaceConfig{Name: "Shape"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Shape interface {\n\t\tArea() int\n\t}", slice(interfaceNode.Node.Range))
+	assert.Equal(t, "// Shape has an area.", slice(interfaceNode.Node.DocRange))
+	assert.Equal(t, "{\n\t\tArea() int\n\t}", slice(interfaceNode.Node.BodyRange))
+
+	typeNode, err := ScoutTypeSource("shapes.go", src, TypeConfig{Name: "Sides"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Sides[T any] []T", slice(typeNode.Node.Range))
+	assert.Equal(t, "Sides[T any]", slice(typeNode.Node.SignatureRange))
+	assert.Equal(t, "[]T", slice(typeNode.Node.BodyRange))
+
+	constNode, err := ScoutConstSource("shapes.go", src, ConstConfig{Name: "Red"})
+	assert.NoError(t, err)
+	assert.Equal(t, "const (\n\tRed = iota\n\tBlue\n)", slice(constNode.Node.Range))
+	assert.Equal(t, "// Colors of a shape.", slice(constNode.Node.DocRange))
+	assert.True(t, constNode.Node.SignatureRange.IsZero())
+	assert.True(t, constNode.Node.BodyRange.IsZero())
+
+	varNode, err := ScoutVarSource("shapes.go", src, VarConfig{Name: "Origin"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Origin = 0", slice(varNode.Node.Range))
+	assert.Equal(t, "// Origin is the center.", slice(varNode.Node.DocRange))
+	assert.True(t, varNode.Node.BodyRange.IsZero())
+}
@@ -1,9 +1,12 @@
 package cmdutils
 
 import (
+	"errors"
+	"os"
 	"strings"
 	"unicode"
 
+	"github.com/fatih/color"
 	"github.com/galactixx/codescout"
 )
 
@@ -41,3 +44,19 @@ func getNameFromNodes(node interface{}) string {
 		return ""
 	}
 }
+
+func isPartialErr(err error) bool {
+	var parseErrs codescout.ParseErrors
+	return errors.As(err, &parseErrs)
+}
+
+func printParseErrors(err error) {
+	var parseErrs codescout.ParseErrors
+	if !errors.As(err, &parseErrs) {
+		return
+	}
+	warning := color.New(color.FgYellow)
+	for _, parseErr := range parseErrs {
+		warning.Fprintln(os.Stderr, "syntax error:", parseErr.Error())
+	}
+}
@@ -0,0 +1,98 @@
+package cmdutils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/galactixx/codescout"
+	"github.com/spf13/cobra"
+)
+
+// IgnoreDirective suppresses violations of the rules named after it, separated by commas or
+// spaces, when written on the line of a declaration, the line above it or in its doc comment.
+const IgnoreDirective = "//codescout:ignore"
+
+// SetFlags sets the flags of a command as if they were passed to it.
+func SetFlags(cmd *cobra.Command, values [][2]string) error {
+	for _, value := range values {
+		if cmd.Flags().Lookup(value[0]) == nil {
+			return fmt.Errorf("flag %s is not a flag of the %s command", value[0], cmd.Name())
+		}
+		setErr := cmd.Flags().Set(value[0], value[1])
+		if setErr != nil {
+			return setErr
+		}
+	}
+	return nil
+}
+
+// Violation is a node selected by a rule that does not match its assertion.
+type Violation struct {
+	Rule        string
+	Description string
+	Kind        string
+	Node        codescout.BaseNode
+}
+
+// String formats the violation as path:line:column: rule: kind name, description.
+func (v Violation) String() string {
+	message := fmt.Sprintf("%s:%d:%d: %s: %s %s", v.Node.Path, v.Node.Line, v.Node.Characters, v.Rule, v.Kind, v.Node.Name)
+	if v.Description != "" {
+		message += ", " + v.Description
+	}
+	return message
+}
+
+// Suppressions finds the ignore directives in source files, reading each file once.
+type Suppressions struct {
+	lines map[string][]string
+}
+
+// NewSuppressions returns an empty Suppressions.
+func NewSuppressions() *Suppressions {
+	return &Suppressions{lines: make(map[string][]string)}
+}
+
+// Suppressed reports whether violations of the rule by the node are ignored in its source.
+func (s *Suppressions) Suppressed(rule string, node codescout.BaseNode) (bool, error) {
+	lines, ok := s.lines[node.Path]
+	if !ok {
+		data, err := os.ReadFile(node.Path)
+		if err != nil {
+			return false, err
+		}
+		lines = strings.Split(string(data), "\n")
+		s.lines[node.Path] = lines
+	}
+
+	first := node.Line - 1
+	if !node.DocRange.IsZero() {
+		first = node.DocRange.Start.Line
+	}
+	for line := first; line <= node.Line; line++ {
+		if line >= 1 && line <= len(lines) && ignores(lines[line-1], rule) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ignores reports whether the line holds an ignore directive naming the rule.
+func ignores(line string, rule string) bool {
+	idx := strings.Index(line, IgnoreDirective)
+	if idx < 0 {
+		return false
+	}
+	rest := line[idx+len(IgnoreDirective):]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return false
+	}
+	names := strings.FieldsFunc(rest, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	for _, name := range names {
+		if name == rule {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package cmdutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFlags(t *testing.T) {
+	cmd := &cobra.Command{Use: "method"}
+	name := flags.CommandFlag[string]{Name: "name"}
+	params := flags.CommandFlag[[]string]{Name: "params"}
+	exact := flags.CommandFlag[bool]{Name: "exact"}
+	flags.StringVarP(cmd, &name, "n", "", "")
+	flags.StringSliceVarP(cmd, &params, "p", make([]string, 0), "")
+	flags.BoolVarP(cmd, &exact, "x", false, "")
+
+	err := SetFlags(cmd, [][2]string{{"name", "Get"}, {"params", "ctx:context.Context"}, {"params", "\"fn:func(a, b int)\""}, {"exact", "true"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Get", name.Variable)
+	assert.Equal(t, []string{"ctx:context.Context", "fn:func(a, b int)"}, params.Variable)
+	assert.True(t, exact.Variable)
+	assert.True(t, cmd.Flags().Changed("name"))
+
+	err = SetFlags(cmd, [][2]string{{"receiver", "Repo"}})
+	assert.EqualError(t, err, "flag receiver is not a flag of the method command")
+}
+
+func TestSuppressions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo.go")
+	src := "package repo\n\n// Get gets.\n//codescout:ignore repo-ctx, other\nfunc Get() {}\n\n//codescout:ignore-all repo-ctx\nfunc List() {}\n\nfunc Count() {} //codescout:ignore repo-ctx\n"
+	require.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+
+	get := codescout.BaseNode{Name: "Get", Path: path, Line: 5, DocRange: codescout.Range{
+		Start: codescout.Position{Line: 3, Column: 1}, End: codescout.Position{Line: 4, Column: 35},
+	}}
+	list := codescout.BaseNode{Name: "List", Path: path, Line: 8}
+	count := codescout.BaseNode{Name: "Count", Path: path, Line: 10}
+
+	suppressions := NewSuppressions()
+	for _, tt := range []struct {
+		rule       string
+		node       codescout.BaseNode
+		suppressed bool
+	}{
+		{"repo-ctx", get, true},
+		{"other", get, true},
+		{"repo", get, false},
+		{"repo-ctx", list, false},
+		{"repo-ctx", count, true},
+	} {
+		suppressed, err := suppressions.Suppressed(tt.rule, tt.node)
+		require.NoError(t, err)
+		assert.Equal(t, tt.suppressed, suppressed, tt.rule+" "+tt.node.Name)
+	}
+
+	violation := Violation{Rule: "repo-ctx", Description: "takes a context", Kind: "method", Node: list}
+	assert.Equal(t, path+":8:0: repo-ctx: method List, takes a context", violation.String())
+}
@@ -0,0 +1,87 @@
+package cmdutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/flags"
+)
+
+const (
+	TextFormat   = "text"
+	JSONFormat   = "json"
+	NDJSONFormat = "ndjson"
+	DOTFormat    = "dot"
+)
+
+var Formats = []string{TextFormat, JSONFormat, NDJSONFormat}
+
+var GraphFormats = []string{DOTFormat, JSONFormat}
+
+func FormatUsage() string {
+	return fmt.Sprintf("output format, must be one of: %s", strings.Join(Formats, ", "))
+}
+
+func FormatValidation(flag flags.CommandFlag[string]) error {
+	if !slices.Contains(Formats, flag.Variable) {
+		return fmt.Errorf("%s flag must be one of: %s", flag.Name, strings.Join(Formats, ", "))
+	}
+	return nil
+}
+
+func GraphFormatUsage() string {
+	return fmt.Sprintf("output format, must be one of: %s", strings.Join(GraphFormats, ", "))
+}
+
+func GraphFormatValidation(flag flags.CommandFlag[string]) error {
+	if !slices.Contains(GraphFormats, flag.Variable) {
+		return fmt.Errorf("%s flag must be one of: %s", flag.Name, strings.Join(GraphFormats, ", "))
+	}
+	return nil
+}
+
+func WriteCallGraph(w io.Writer, format string, graph codescout.CallGraph) error {
+	if format == DOTFormat {
+		_, err := io.WriteString(w, graph.DOT())
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(graph)
+}
+
+func WriteMatches(w io.Writer, format string, matches []*codescout.QueryMatch) error {
+	if format != TextFormat {
+		return writeRecords(w, format, matches)
+	}
+
+	for _, match := range matches {
+		_, err := fmt.Fprintf(w, "%s:%d:%d\t%s\t%s\n", match.Node.Path, match.Node.Line, match.Node.Characters, match.Kind, match.Node.Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeRecords[T any](w io.Writer, format string, nodes []*T) error {
+	if format == NDJSONFormat {
+		encoder := json.NewEncoder(w)
+		for _, node := range nodes {
+			err := encoder.Encode(node)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(nodes)
+}
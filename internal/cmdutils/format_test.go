@@ -0,0 +1,63 @@
+package cmdutils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/galactixx/codescout"
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatValidation(t *testing.T) {
+	assert.NoError(t, FormatValidation(flags.CommandFlag[string]{Name: "format", Variable: JSONFormat}))
+	err := FormatValidation(flags.CommandFlag[string]{Name: "format", Variable: "xml"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be one of")
+}
+
+func TestWriteRecords(t *testing.T) {
+	type record struct {
+		Name string `json:"name"`
+		Line int    `json:"line"`
+	}
+	nodes := []*record{{Name: "Greet", Line: 3}, {Name: "main", Line: 9}}
+
+	var ndjson bytes.Buffer
+	assert.NoError(t, writeRecords(&ndjson, NDJSONFormat, nodes))
+	assert.Equal(t, "{\"name\":\"Greet\",\"line\":3}\n{\"name\":\"main\",\"line\":9}\n", ndjson.String())
+
+	var json bytes.Buffer
+	assert.NoError(t, writeRecords(&json, JSONFormat, nodes))
+	assert.Equal(t, "[\n  {\n    \"name\": \"Greet\",\n    \"line\": 3\n  },\n  {\n    \"name\": \"main\",\n    \"line\": 9\n  }\n]\n", json.String())
+}
+
+func TestWriteCallGraph(t *testing.T) {
+	graph := codescout.CallGraph{Edges: []codescout.CallEdge{
+		{Caller: "main.main", Callee: "fmt.Println", Path: "main.go", Line: 4, Column: 2},
+		{Caller: "main.main", Callee: "fmt.Println", Path: "main.go", Line: 5, Column: 2},
+	}}
+
+	var dot bytes.Buffer
+	assert.NoError(t, WriteCallGraph(&dot, DOTFormat, graph))
+	assert.Equal(t, "digraph calls {\n\t\"main.main\" -> \"fmt.Println\";\n}\n", dot.String())
+
+	var json bytes.Buffer
+	assert.NoError(t, WriteCallGraph(&json, JSONFormat, graph))
+	assert.Contains(t, json.String(), "\"callee\": \"fmt.Println\"")
+	assert.Contains(t, json.String(), "\"line\": 5")
+}
+
+func TestWriteMatches(t *testing.T) {
+	matches := []*codescout.QueryMatch{
+		{Kind: "func", Node: codescout.BaseNode{Name: "Greet", Path: "main.go", Line: 3, Characters: 1}},
+	}
+
+	var text bytes.Buffer
+	assert.NoError(t, WriteMatches(&text, TextFormat, matches))
+	assert.Equal(t, "main.go:3:1\tfunc\tGreet\n", text.String())
+
+	var ndjson bytes.Buffer
+	assert.NoError(t, WriteMatches(&ndjson, NDJSONFormat, matches))
+	assert.Equal(t, "{\"kind\":\"func\",\"node\":null}\n", ndjson.String())
+}
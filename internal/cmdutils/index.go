@@ -0,0 +1,36 @@
+package cmdutils
+
+import "github.com/galactixx/codescout"
+
+const DefaultIndexPath = ".codescout/index.json"
+
+// OpenIndex loads the index of root saved at indexPath, brings it up to date and saves it
+// again if any file changed, returning the number of files updated.
+func OpenIndex(indexPath string, root string) (*codescout.Index, int, error) {
+	index, err := codescout.LoadIndex(indexPath, root)
+	if err != nil {
+		return nil, 0, err
+	}
+	updated, err := index.Update()
+	if err != nil {
+		return nil, 0, err
+	}
+	if updated > 0 {
+		saveErr := index.Save(indexPath)
+		if saveErr != nil {
+			return nil, 0, saveErr
+		}
+	}
+	return index, updated, nil
+}
+
+// IndexScouts adapts the lookups of an index to the scout functions of a ScoutContainer,
+// whose path is already covered by the index.
+func IndexScouts[T any, C any](
+	scoutFirst func(config C) (*T, error),
+	scoutAll func(config C) ([]*T, error),
+) (func(path string, config C) (*T, error), func(path string, config C) ([]*T, error)) {
+	first := func(_ string, config C) (*T, error) { return scoutFirst(config) }
+	all := func(_ string, config C) ([]*T, error) { return scoutAll(config) }
+	return first, all
+}
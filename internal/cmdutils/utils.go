@@ -3,9 +3,10 @@ package cmdutils
 import (
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/fatih/color"
@@ -65,22 +66,107 @@ func argsToNamedTypes(argTypes []string, parameterTypes *[]codescout.NamedType)
 	return nil
 }
 
+const ExactMatch = "exact"
+
+var MatchModes = []string{ExactMatch, string(codescout.GlobPattern), string(codescout.RegexPattern)}
+
+func MatchModeUsage() string {
+	return fmt.Sprintf("how name flags are matched, must be one of: %s", strings.Join(MatchModes, ", "))
+}
+
+func NamePattern(match string, value string) (string, string, codescout.PatternMode) {
+	if match == "" || match == ExactMatch {
+		return value, "", ""
+	}
+	return "", value, codescout.PatternMode(match)
+}
+
+func matchModeValidation(flag flags.CommandFlag[string]) error {
+	if !slices.Contains(MatchModes, flag.Variable) {
+		return fmt.Errorf("%s flag must be one of: %s", flag.Name, strings.Join(MatchModes, ", "))
+	}
+	return nil
+}
+
+var TypeMatchModes = []string{
+	string(codescout.TextualTypes), string(codescout.IdenticalTypes), string(codescout.AssignableTypes),
+}
+
+func TypeMatchUsage() string {
+	return fmt.Sprintf("how parameter, return and field types are compared, must be one of: %s", strings.Join(TypeMatchModes, ", "))
+}
+
+func typeMatchValidation(flag flags.CommandFlag[string]) error {
+	if !slices.Contains(TypeMatchModes, flag.Variable) {
+		return fmt.Errorf("%s flag must be one of: %s", flag.Name, strings.Join(TypeMatchModes, ", "))
+	}
+	return nil
+}
+
+var SortOrders = []string{
+	string(codescout.PositionSort), string(codescout.NameSort), string(codescout.ExportedSort),
+}
+
+func SortUsage() string {
+	return fmt.Sprintf("order of the occurrences, must be one of: %s", strings.Join(SortOrders, ", "))
+}
+
+func sortValidation(flag flags.CommandFlag[string]) error {
+	if !slices.Contains(SortOrders, flag.Variable) {
+		return fmt.Errorf("%s flag must be one of: %s", flag.Name, strings.Join(SortOrders, ", "))
+	}
+	return nil
+}
+
+func ArgsToTagFilters(tags []string, missingTags []string) ([]codescout.TagFilter, error) {
+	tagFilters := make([]codescout.TagFilter, 0, len(tags)+len(missingTags))
+	for _, tag := range tags {
+		key, value, _ := strings.Cut(tag, ":")
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, errors.New("the tag key must be defined")
+		}
+		if unquoted, err := strconv.Unquote(strings.TrimSpace(value)); err == nil {
+			value = unquoted
+		}
+		tagFilters = append(tagFilters, codescout.TagFilter{Key: key, Value: strings.TrimSpace(value)})
+	}
+	for _, key := range missingTags {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, errors.New("the tag key must be defined")
+		}
+		tagFilters = append(tagFilters, codescout.TagFilter{Key: key, Missing: true})
+	}
+	return tagFilters, nil
+}
+
+func ArgsToNamedTypes(argTypes []string) ([]codescout.NamedType, error) {
+	namedTypes := make([]codescout.NamedType, 0, len(argTypes))
+	err := argsToNamedTypes(argTypes, &namedTypes)
+	if err != nil {
+		return nil, err
+	}
+	return namedTypes, nil
+}
+
+func TypeParamsOutput(typeParams []codescout.NamedType) string {
+	params := make([]string, 0, len(typeParams))
+	for _, param := range typeParams {
+		params = append(params, param.Name+" "+param.Type)
+	}
+	return strings.Join(params, "\n")
+}
+
 type CobraCommandVlidation[T any] struct {
 	Validator      flags.BatchValidator
 	NamedTypesFlag *flags.CommandFlag[[]string]
 	OutputTypeFlag *flags.CommandFlag[string]
+	MatchModeFlag  *flags.CommandFlag[string]
+	FormatFlag     *flags.CommandFlag[string]
+	TypeMatchFlag  *flags.CommandFlag[string]
+	SortFlag       *flags.CommandFlag[string]
 	OutputOptions  OutputOptions[T]
-
-	namedTypes []codescout.NamedType
-}
-
-func (v *CobraCommandVlidation[T]) GetNamedTypes() []codescout.NamedType {
-	if v.namedTypes == nil {
-		log.Fatal("named types field is returning nil, should never occur")
-	}
-	namedTypes := v.namedTypes
-	v.namedTypes = nil
-	return namedTypes
 }
 
 func (v *CobraCommandVlidation[T]) CommandValidation(cmd *cobra.Command) error {
@@ -89,12 +175,40 @@ func (v *CobraCommandVlidation[T]) CommandValidation(cmd *cobra.Command) error {
 		return validationErr
 	}
 
-	namedTypes := make([]codescout.NamedType, 0, 5)
-	err := argsToNamedTypes(v.NamedTypesFlag.Variable, &namedTypes)
-	if err != nil {
-		return err
+	if v.NamedTypesFlag != nil {
+		_, namedTypesErr := ArgsToNamedTypes(v.NamedTypesFlag.Variable)
+		if namedTypesErr != nil {
+			return namedTypesErr
+		}
+	}
+
+	if v.MatchModeFlag != nil {
+		matchErr := matchModeValidation(*v.MatchModeFlag)
+		if matchErr != nil {
+			return matchErr
+		}
+	}
+
+	if v.FormatFlag != nil {
+		formatErr := FormatValidation(*v.FormatFlag)
+		if formatErr != nil {
+			return formatErr
+		}
+	}
+
+	if v.TypeMatchFlag != nil {
+		typeMatchErr := typeMatchValidation(*v.TypeMatchFlag)
+		if typeMatchErr != nil {
+			return typeMatchErr
+		}
+	}
+
+	if v.SortFlag != nil {
+		sortErr := sortValidation(*v.SortFlag)
+		if sortErr != nil {
+			return sortErr
+		}
 	}
-	v.namedTypes = namedTypes
 
 	outputErr := v.OutputOptions.validation(cmd, *v.OutputTypeFlag)
 	if outputErr != nil {
@@ -144,6 +258,36 @@ type ScoutContainer[T any, C any] struct {
 	Config         C
 	DefType        string
 	OutputType     string
+	Format         string
+}
+
+func (c ScoutContainer[T, C]) WithFormat(format string) ScoutContainer[T, C] {
+	c.Format = format
+	return c
+}
+
+func (c ScoutContainer[T, C]) displayRecords(verbose bool) error {
+	var nodes []*T
+	var err error
+	if verbose {
+		nodes, err = c.ScoutAll(c.Path, c.Config)
+		if err != nil && !isPartialErr(err) {
+			return err
+		}
+	} else {
+		var node *T
+		node, err = c.ScoutFirst(c.Path, c.Config)
+		if err != nil && (node == nil || !isPartialErr(err)) {
+			return err
+		}
+		nodes = []*T{node}
+	}
+	writeErr := writeRecords(os.Stdout, c.Format, nodes)
+	if writeErr != nil {
+		return writeErr
+	}
+	printParseErrors(err)
+	return nil
 }
 
 func (c ScoutContainer[T, C]) getNodesBoxWidth(nodes []*T) int {
@@ -169,9 +313,12 @@ func (c ScoutContainer[T, C]) getBoxWidth(maxLenght int) int {
 }
 
 func (c ScoutContainer[T, C]) Display(verbose bool) error {
+	if c.Format == JSONFormat || c.Format == NDJSONFormat {
+		return c.displayRecords(verbose)
+	}
 	if verbose {
 		nodes, err := c.ScoutAll(c.Path, c.Config)
-		if err != nil {
+		if err != nil && !isPartialErr(err) {
 			return err
 		}
 		boxWidth := c.getNodesBoxWidth(nodes)
@@ -179,14 +326,16 @@ func (c ScoutContainer[T, C]) Display(verbose bool) error {
 			name := getNameFromNodes(node)
 			c.printOutput(name, idx == 0, c.getOutput(node), boxWidth)
 		}
+		printParseErrors(err)
 	} else {
 		node, err := c.ScoutFirst(c.Path, c.Config)
-		if err != nil {
+		if err != nil && (node == nil || !isPartialErr(err)) {
 			return err
 		}
 		boxWidth := c.getNodeBoxWidth(node)
 		name := getNameFromNodes(node)
 		c.printOutput(name, true, c.getOutput(node), boxWidth)
+		printParseErrors(err)
 	}
 	return nil
 }
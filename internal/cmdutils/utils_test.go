@@ -74,3 +74,24 @@ func TestArgsToNamedTypesEmptyParts(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "must be defined")
 }
+
+func TestArgsToTagFilters(t *testing.T) {
+	tagFilters, err := ArgsToTagFilters([]string{`json:"-"`, "db", "validate:required"}, []string{"yaml"})
+	assert.NoError(t, err)
+	assert.Equal(t, []codescout.TagFilter{
+		{Key: "json", Value: "-"},
+		{Key: "db"},
+		{Key: "validate", Value: "required"},
+		{Key: "yaml", Missing: true},
+	}, tagFilters)
+
+	_, err = ArgsToTagFilters([]string{":-"}, nil)
+	assert.Error(t, err)
+}
+
+func TestSortValidation(t *testing.T) {
+	assert.NoError(t, sortValidation(flags.CommandFlag[string]{Name: "sort", Variable: string(codescout.NameSort)}))
+	err := sortValidation(flags.CommandFlag[string]{Name: "sort", Variable: "size"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "must be one of")
+}
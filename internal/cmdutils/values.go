@@ -0,0 +1,82 @@
+package cmdutils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/galactixx/codescout/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+// ConfigValues reads the values a config is built from by flag long name, so that the same
+// mapping builds a config from the flags of a command and from a rule of the config file.
+type ConfigValues interface {
+	String(name string) string
+	Strings(name string) []string
+	Bool(name string) bool
+	OptionalBool(name string) *bool
+	OneOf(name string, valid []string, defaultValue string) string
+	// Err returns the first value that could not be read.
+	Err() error
+}
+
+// FlagValues reads the flags of a command as ConfigValues, keeping the first error.
+type FlagValues struct {
+	cmd *cobra.Command
+	err error
+}
+
+// NewFlagValues returns the ConfigValues of the flags of the command.
+func NewFlagValues(cmd *cobra.Command) *FlagValues { return &FlagValues{cmd: cmd} }
+
+func (v *FlagValues) fail(err error) {
+	if v.err == nil {
+		v.err = err
+	}
+}
+
+// String returns the value of a string flag.
+func (v *FlagValues) String(name string) string {
+	value, err := v.cmd.Flags().GetString(name)
+	if err != nil {
+		v.fail(err)
+	}
+	return value
+}
+
+// Strings returns the values of a slice flag.
+func (v *FlagValues) Strings(name string) []string {
+	values, err := v.cmd.Flags().GetStringSlice(name)
+	if err != nil {
+		v.fail(err)
+	}
+	return values
+}
+
+// Bool returns the value of a boolean flag.
+func (v *FlagValues) Bool(name string) bool {
+	value, err := v.cmd.Flags().GetBool(name)
+	if err != nil {
+		v.fail(err)
+	}
+	return value
+}
+
+// OptionalBool returns the value of a true/false string flag, or nil when it is not set.
+func (v *FlagValues) OptionalBool(name string) *bool {
+	return flags.StringBoolToPointer(v.String(name))
+}
+
+// OneOf returns the value of a string flag that must be one of the valid values, the flag's
+// default when it is not set.
+func (v *FlagValues) OneOf(name string, valid []string, defaultValue string) string {
+	value := v.String(name)
+	if !slices.Contains(valid, value) {
+		v.fail(fmt.Errorf("%s flag must be one of: %s", name, strings.Join(valid, ", ")))
+	}
+	return value
+}
+
+// Err returns the first flag that could not be read.
+func (v *FlagValues) Err() error { return v.err }
@@ -0,0 +1,30 @@
+package cmdutils
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagValues(t *testing.T) {
+	cmd := &cobra.Command{Use: "method"}
+	cmd.Flags().String("name", "", "")
+	cmd.Flags().StringSlice("params", nil, "")
+	cmd.Flags().String("pointer", "", "")
+	cmd.Flags().Bool("exact", false, "")
+	cmd.Flags().String("match", ExactMatch, "")
+	require.NoError(t, cmd.ParseFlags([]string{"--name=Get", "--params=ctx:context.Context,id:int", "--exact", "--match=glob"}))
+
+	values := NewFlagValues(cmd)
+	assert.Equal(t, "Get", values.String("name"))
+	assert.Equal(t, []string{"ctx:context.Context", "id:int"}, values.Strings("params"))
+	assert.Nil(t, values.OptionalBool("pointer"))
+	assert.True(t, values.Bool("exact"))
+	assert.Equal(t, "glob", values.OneOf("match", MatchModes, ExactMatch))
+	require.NoError(t, values.Err())
+
+	values.Bool("receiver")
+	assert.EqualError(t, values.Err(), "flag accessed but not defined: receiver")
+}
@@ -0,0 +1,354 @@
+package configfile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Names of the config file, looked up in this order. JSON is read as YAML.
+var Names = []string{".codescout.yaml", ".codescout.yml", ".codescout.json"}
+
+// Kinds of node a saved query or rule can scout, named after the commands running them.
+var Kinds = []string{"func", "method", "struct"}
+
+// File is a codescout config file.
+type File struct {
+	// Path the file was read from, relative paths in the file are resolved against its directory.
+	Path    string           `yaml:"-"`
+	Queries map[string]Query `yaml:"queries"`
+	Rules   map[string]Rule  `yaml:"rules"`
+}
+
+// Query is a saved query, run by the command of its kind with its flags set.
+type Query struct {
+	Kind        string `yaml:"kind"`
+	Description string `yaml:"description"`
+	// Paths scouted, a file, directory, recursive ./... pattern or package each.
+	Paths []string `yaml:"paths"`
+	// Format of the output, the --format flag.
+	Format string `yaml:"format"`
+	// Flags by their long name, e.g. receiver: Server or params: [ctx:context.Context].
+	Flags map[string]any `yaml:"flags"`
+}
+
+// Rule is a convention checked by the check command: every node of its kind selected by the
+// Select flags must also match the Assert flags, or it is reported as a violation.
+type Rule struct {
+	Kind        string `yaml:"kind"`
+	Description string `yaml:"description"`
+	// Paths checked, a file, directory, recursive ./... pattern or package each.
+	Paths []string `yaml:"paths"`
+	// Select and Assert flags by their long name, as for a Query without the output flags.
+	// Parameters are matched whatever their position unless ordered-params is set, e.g.
+	// params: [ctx:context.Context] with ordered-params: true asserts that ctx comes first.
+	Select map[string]any `yaml:"select"`
+	Assert map[string]any `yaml:"assert"`
+}
+
+// Find returns the config file in dir or its closest parent containing one, stopping at the
+// repository root, the first directory containing .git.
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		for _, name := range Names {
+			path := filepath.Join(dir, name)
+			if _, statErr := os.Stat(path); statErr == nil {
+				return path, nil
+			}
+		}
+		_, gitErr := os.Stat(filepath.Join(dir, ".git"))
+		parent := filepath.Dir(dir)
+		if gitErr == nil || parent == dir {
+			return "", fmt.Errorf("no config file was found, expected one of: %s", strings.Join(Names, ", "))
+		}
+		dir = parent
+	}
+}
+
+// Load reads and validates the config file at path.
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := File{Path: path}
+	if unmarshalErr := yaml.Unmarshal(data, &file); unmarshalErr != nil {
+		return nil, fmt.Errorf("config file %s could not be read: %w", path, unmarshalErr)
+	}
+	for _, name := range file.QueryNames() {
+		if queryErr := file.Queries[name].validate(); queryErr != nil {
+			return nil, fmt.Errorf("query %s: %w", name, queryErr)
+		}
+	}
+	for _, name := range file.RuleNames() {
+		if ruleErr := file.Rules[name].validate(name); ruleErr != nil {
+			return nil, fmt.Errorf("rule %s: %w", name, ruleErr)
+		}
+	}
+	return &file, nil
+}
+
+// QueryNames returns the names of the saved queries in order.
+func (f File) QueryNames() []string { return sortedKeys(f.Queries) }
+
+// RuleNames returns the names of the rules in order.
+func (f File) RuleNames() []string { return sortedKeys(f.Rules) }
+
+// Query returns the saved query with the name.
+func (f File) Query(name string) (Query, error) {
+	query, ok := f.Queries[name]
+	if !ok {
+		return Query{}, fmt.Errorf("no query named %s in %s", name, f.Path)
+	}
+	return query, nil
+}
+
+// Rule returns the rule with the name.
+func (f File) Rule(name string) (Rule, error) {
+	rule, ok := f.Rules[name]
+	if !ok {
+		return Rule{}, fmt.Errorf("no rule named %s in %s", name, f.Path)
+	}
+	return rule, nil
+}
+
+// ResolvePaths returns the paths of a query or rule resolved against the directory of the
+// config file, so it runs the same from any directory. Paths that do not exist there, such as
+// package import paths, are kept as they are.
+func (f File) ResolvePaths(paths []string) []string {
+	dir := filepath.Dir(f.Path)
+	resolvedPaths := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if filepath.IsAbs(path) {
+			resolvedPaths = append(resolvedPaths, path)
+			continue
+		}
+		resolved := filepath.Join(dir, path)
+		if strings.HasSuffix(path, "...") {
+			resolved = filepath.Join(dir, strings.TrimSuffix(path, "...")) + string(filepath.Separator) + "..."
+		}
+		if _, statErr := os.Stat(strings.TrimSuffix(resolved, "...")); statErr != nil {
+			resolved = path
+		}
+		resolvedPaths = append(resolvedPaths, resolved)
+	}
+	return resolvedPaths
+}
+
+func (q Query) validate() error {
+	if !slices.Contains(Kinds, q.Kind) {
+		return fmt.Errorf("kind must be one of: %s", strings.Join(Kinds, ", "))
+	}
+	if len(q.Paths) == 0 {
+		return errors.New("at least one path must be specified")
+	}
+	_, err := q.FlagValues()
+	return err
+}
+
+// FlagValues returns the values to set the flags of the query to.
+func (q Query) FlagValues() ([][2]string, error) { return flagValues(q.Flags) }
+
+func (r Rule) validate(name string) error {
+	if strings.ContainsAny(name, ", \t") {
+		return errors.New("name must not contain commas or spaces, so that it can be ignored in source")
+	}
+	if !slices.Contains(Kinds, r.Kind) {
+		return fmt.Errorf("kind must be one of: %s", strings.Join(Kinds, ", "))
+	}
+	if len(r.Paths) == 0 {
+		return errors.New("at least one path must be specified")
+	}
+	if len(r.Assert) == 0 {
+		return errors.New("at least one assertion must be specified")
+	}
+	if _, selectErr := flagValues(r.Select); selectErr != nil {
+		return selectErr
+	}
+	_, err := flagValues(r.Assert)
+	return err
+}
+
+// SelectFlags returns the flags selecting the nodes checked by the rule.
+func (r Rule) SelectFlags() *Values { return newValues(r.Kind, r.Select) }
+
+// AssertFlags returns the flags every selected node must match.
+func (r Rule) AssertFlags() *Values { return newValues(r.Kind, r.Assert) }
+
+// Values reads the flags of a rule by their long names as the values of a config, so that
+// the config is built from the rule directly rather than through the flags of a command. The
+// first flag that cannot be read is kept as the error returned by Err.
+type Values struct {
+	kind  string
+	flags map[string]any
+	read  map[string]bool
+	err   error
+}
+
+func newValues(kind string, flags map[string]any) *Values {
+	return &Values{kind: kind, flags: flags, read: make(map[string]bool)}
+}
+
+// lookup returns the value of the flag and whether it is set, marking it as read.
+func (v *Values) lookup(name string) (any, bool) {
+	v.read[name] = true
+	value, ok := v.flags[name]
+	return value, ok
+}
+
+// fail keeps the error unless an earlier one was kept.
+func (v *Values) fail(err error) {
+	if v.err == nil {
+		v.err = err
+	}
+}
+
+// String returns the value of a string flag, or "" when it is not set.
+func (v *Values) String(name string) string {
+	value, ok := v.lookup(name)
+	if !ok {
+		return ""
+	}
+	scalar, err := scalarValue(name, value)
+	if err != nil {
+		v.fail(err)
+	}
+	return scalar
+}
+
+// Strings returns the values of a slice flag, written as a list or a single value.
+func (v *Values) Strings(name string) []string {
+	value, ok := v.lookup(name)
+	if !ok {
+		return make([]string, 0)
+	}
+	elements, isList := value.([]any)
+	if !isList {
+		elements = []any{value}
+	}
+	values := make([]string, 0, len(elements))
+	for _, element := range elements {
+		scalar, err := scalarValue(name, element)
+		if err != nil {
+			v.fail(err)
+			return nil
+		}
+		values = append(values, scalar)
+	}
+	return values
+}
+
+// OneOf returns the value of a string flag that must be one of the valid values, or the
+// default value when it is not set.
+func (v *Values) OneOf(name string, valid []string, defaultValue string) string {
+	if _, ok := v.flags[name]; !ok {
+		v.read[name] = true
+		return defaultValue
+	}
+	value := v.String(name)
+	if !slices.Contains(valid, value) {
+		v.fail(fmt.Errorf("flag %s must be one of: %s", name, strings.Join(valid, ", ")))
+	}
+	return value
+}
+
+// Bool returns the value of a boolean flag, false when it is not set.
+func (v *Values) Bool(name string) bool {
+	value := v.OptionalBool(name)
+	return value != nil && *value
+}
+
+// OptionalBool returns the value of a boolean flag, or nil when it is not set.
+func (v *Values) OptionalBool(name string) *bool {
+	value, ok := v.lookup(name)
+	if !ok {
+		return nil
+	}
+	switch value := value.(type) {
+	case bool:
+		return &value
+	case string:
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return &parsed
+		}
+	}
+	v.fail(fmt.Errorf("flag %s must be true or false", name))
+	return nil
+}
+
+// Err returns the first flag that could not be read, or else the first flag that was never
+// read, which a rule of its kind cannot use.
+func (v *Values) Err() error {
+	if v.err != nil {
+		return v.err
+	}
+	for _, name := range sortedKeys(v.flags) {
+		if !v.read[name] {
+			return fmt.Errorf("flag %s cannot be used in a %s rule", name, v.kind)
+		}
+	}
+	return nil
+}
+
+// flagValues returns the values to set each flag to, in flag name order. A list sets a slice
+// flag once per element, and every other value is set as it is written.
+func flagValues(flags map[string]any) ([][2]string, error) {
+	names := sortedKeys(flags)
+	values := make([][2]string, 0, len(names))
+	for _, name := range names {
+		switch value := flags[name].(type) {
+		case []any:
+			for _, element := range value {
+				scalar, err := scalarValue(name, element)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, [2]string{name, csvQuote(scalar)})
+			}
+		default:
+			scalar, err := scalarValue(name, value)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, [2]string{name, scalar})
+		}
+	}
+	return values, nil
+}
+
+func scalarValue(name string, value any) (string, error) {
+	switch value.(type) {
+	case string, bool, int, float64:
+		return fmt.Sprint(value), nil
+	}
+	return "", fmt.Errorf("flag %s must be a string, boolean, number or list of them", name)
+}
+
+// csvQuote quotes a slice flag element containing a comma or quote, which slice flags read as CSV.
+func csvQuote(value string) string {
+	if !strings.ContainsAny(value, ",\"") {
+		return value
+	}
+	return "\"" + strings.ReplaceAll(value, "\"", "\"\"") + "\""
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,146 @@
+package configfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `queries:
+  ctx-handlers:
+    kind: method
+    description: Server methods taking a context
+    paths: ["./...", "net/http"]
+    format: json
+    flags:
+      receiver: Server
+      pointer: true
+      params: ["ctx:context.Context", "fn:func(a, b int)"]
+  errors:
+    kind: func
+    paths: [cmd]
+    flags:
+      return: error
+rules:
+  repo-ctx:
+    kind: method
+    paths: ["./..."]
+    select:
+      receiver: Repo
+    assert:
+      params: ["ctx:context.Context"]
+`
+
+func TestLoad(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, ".git"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "cmd", "app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".codescout.yaml"), []byte(testConfig), 0o644))
+
+	path, err := Find(filepath.Join(root, "cmd", "app"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, ".codescout.yaml"), path)
+
+	file, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ctx-handlers", "errors"}, file.QueryNames())
+
+	query, err := file.Query("ctx-handlers")
+	require.NoError(t, err)
+	assert.Equal(t, "method", query.Kind)
+	assert.Equal(t, "json", query.Format)
+	assert.Equal(t, []string{filepath.Join(root, "..."), "net/http"}, file.ResolvePaths(query.Paths))
+
+	values, err := query.FlagValues()
+	require.NoError(t, err)
+	assert.Equal(t, [][2]string{
+		{"params", "ctx:context.Context"},
+		{"params", "\"fn:func(a, b int)\""},
+		{"pointer", "true"},
+		{"receiver", "Server"},
+	}, values)
+
+	errorsQuery, err := file.Query("errors")
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(root, "cmd")}, file.ResolvePaths(errorsQuery.Paths))
+
+	_, err = file.Query("missing")
+	assert.EqualError(t, err, "no query named missing in "+path)
+
+	assert.Equal(t, []string{"repo-ctx"}, file.RuleNames())
+	rule, err := file.Rule("repo-ctx")
+	require.NoError(t, err)
+	selectFlags := rule.SelectFlags()
+	assert.Equal(t, "Repo", selectFlags.String("receiver"))
+	assert.Nil(t, selectFlags.OptionalBool("pointer"))
+	require.NoError(t, selectFlags.Err())
+	assertFlags := rule.AssertFlags()
+	assert.Equal(t, []string{"ctx:context.Context"}, assertFlags.Strings("params"))
+	require.NoError(t, assertFlags.Err())
+
+	_, err = file.Rule("missing")
+	assert.EqualError(t, err, "no rule named missing in "+path)
+}
+
+func TestValues(t *testing.T) {
+	rule := Rule{Kind: "method", Assert: map[string]any{
+		"params":  "ctx:context.Context",
+		"return":  []any{"error", 1},
+		"exact":   "true",
+		"pointer": false,
+		"match":   "glob",
+	}}
+	values := rule.AssertFlags()
+	assert.Equal(t, []string{"ctx:context.Context"}, values.Strings("params"))
+	assert.Equal(t, []string{"error", "1"}, values.Strings("return"))
+	assert.Empty(t, values.Strings("fields"))
+	assert.True(t, values.Bool("exact"))
+	assert.Equal(t, false, *values.OptionalBool("pointer"))
+	assert.Equal(t, "glob", values.OneOf("match", []string{"exact", "glob"}, "exact"))
+	assert.Equal(t, "textual", values.OneOf("type-match", []string{"textual"}, "textual"))
+	require.NoError(t, values.Err())
+
+	values = Rule{Kind: "method", Assert: map[string]any{"exact": "yes", "match": "fuzzy"}}.AssertFlags()
+	values.Bool("exact")
+	values.OneOf("match", []string{"exact", "glob"}, "exact")
+	assert.EqualError(t, values.Err(), "flag exact must be true or false")
+
+	values = Rule{Kind: "method", Assert: map[string]any{"match": "fuzzy"}}.AssertFlags()
+	values.OneOf("match", []string{"exact", "glob"}, "exact")
+	assert.EqualError(t, values.Err(), "flag match must be one of: exact, glob")
+
+	values = Rule{Kind: "func", Assert: map[string]any{"receiver": "Repo"}}.AssertFlags()
+	values.String("name")
+	assert.EqualError(t, values.Err(), "flag receiver cannot be used in a func rule")
+}
+
+func TestLoadErrors(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, ".git"), 0o755))
+	_, err := Find(root)
+	assert.EqualError(t, err, "no config file was found, expected one of: .codescout.yaml, .codescout.yml, .codescout.json")
+
+	path := filepath.Join(root, ".codescout.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"queries": {"q": {"kind": "interface", "paths": ["."]}}}`), 0o644))
+	_, err = Load(path)
+	assert.EqualError(t, err, "query q: kind must be one of: func, method, struct")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"queries": {"q": {"kind": "func"}}}`), 0o644))
+	_, err = Load(path)
+	assert.EqualError(t, err, "query q: at least one path must be specified")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"queries": {"q": {"kind": "func", "paths": ["."], "flags": {"name": {"a": 1}}}}}`), 0o644))
+	_, err = Load(path)
+	assert.EqualError(t, err, "query q: flag name must be a string, boolean, number or list of them")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"rules": {"r": {"kind": "func", "paths": ["."]}}}`), 0o644))
+	_, err = Load(path)
+	assert.EqualError(t, err, "rule r: at least one assertion must be specified")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"rules": {"r 1": {"kind": "func", "paths": ["."], "assert": {"name": "F"}}}}`), 0o644))
+	_, err = Load(path)
+	assert.EqualError(t, err, "rule r 1: name must not contain commas or spaces, so that it can be ignored in source")
+}
@@ -5,10 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/importer"
 	"go/parser"
 	"go/printer"
 	"go/token"
+	"go/types"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -18,10 +25,11 @@ func MethodWithoutReceiver(funcDecl *ast.FuncDecl) bool {
 }
 
 func FromEmptyMapKeysToSlice(someMap map[string]*int) []string {
-	fieldsAccessed := make([]string, 0, 10)
+	fieldsAccessed := make([]string, 0, len(someMap))
 	for key := range someMap {
 		fieldsAccessed = append(fieldsAccessed, key)
 	}
+	sort.Strings(fieldsAccessed)
 	return fieldsAccessed
 }
 
@@ -58,6 +66,276 @@ func ExprToString(expr ast.Expr) string {
 	}
 }
 
+func UnionTerms(expr ast.Expr) []ast.Expr {
+	if binary, ok := expr.(*ast.BinaryExpr); ok && binary.Op == token.OR {
+		return append(UnionTerms(binary.X), UnionTerms(binary.Y)...)
+	}
+	return []ast.Expr{expr}
+}
+
+func IsTypeSetTerm(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		return e.Op == token.OR
+	case *ast.UnaryExpr:
+		return e.Op == token.TILDE
+	case *ast.Ident:
+		obj := types.Universe.Lookup(e.Name)
+		if obj == nil {
+			return false
+		}
+		_, isTypeName := obj.(*types.TypeName)
+		return isTypeName && !types.IsInterface(obj.Type())
+	case *ast.ArrayType, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.StructType, *ast.StarExpr:
+		return true
+	default:
+		return false
+	}
+}
+
+func ImportType(pkgPath string, name string) (types.Type, error) {
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(pkgPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not import package %s: %w", pkgPath, err)
+	}
+	obj := pkg.Scope().Lookup(name)
+	if obj == nil {
+		return nil, fmt.Errorf("%s is not declared in package %s", name, pkgPath)
+	}
+	if _, isTypeName := obj.(*types.TypeName); !isTypeName {
+		return nil, fmt.Errorf("%s.%s is not a type", pkgPath, name)
+	}
+	return obj.Type(), nil
+}
+
+func ImportInterface(pkgPath string, name string) (*types.Interface, error) {
+	typ, err := ImportType(pkgPath, name)
+	if err != nil {
+		return nil, err
+	}
+	iface, ok := typ.Underlying().(*types.Interface)
+	if !ok {
+		return nil, fmt.Errorf("%s.%s is not an interface", pkgPath, name)
+	}
+	return iface.Complete(), nil
+}
+
+func SignatureString(sig *types.Signature) string {
+	qualifier := func(pkg *types.Package) string { return pkg.Name() }
+	tupleTypes := func(tuple *types.Tuple, variadic bool) []string {
+		tupleTypes := make([]string, 0, tuple.Len())
+		for idx := 0; idx < tuple.Len(); idx++ {
+			varType := tuple.At(idx).Type()
+			if variadic && idx == tuple.Len()-1 {
+				elem := varType.(*types.Slice).Elem()
+				tupleTypes = append(tupleTypes, "..."+types.TypeString(elem, qualifier))
+			} else {
+				tupleTypes = append(tupleTypes, types.TypeString(varType, qualifier))
+			}
+		}
+		return tupleTypes
+	}
+	params := tupleTypes(sig.Params(), sig.Variadic())
+	results := tupleTypes(sig.Results(), false)
+	return "(" + strings.Join(params, ", ") + ") (" + strings.Join(results, ", ") + ")"
+}
+
+func FuncTypeString(fset *token.FileSet, funcType *ast.FuncType) string {
+	fieldTypes := func(fields *ast.FieldList) []string {
+		fieldTypes := make([]string, 0, 5)
+		if fields == nil {
+			return fieldTypes
+		}
+		for _, field := range fields.List {
+			fieldType := NodeToCode(fset, field.Type)
+			for count := 0; count < max(len(field.Names), 1); count++ {
+				fieldTypes = append(fieldTypes, fieldType)
+			}
+		}
+		return fieldTypes
+	}
+	params := fieldTypes(funcType.Params)
+	results := fieldTypes(funcType.Results)
+	return "(" + strings.Join(params, ", ") + ") (" + strings.Join(results, ", ") + ")"
+}
+
+func EvalConstExpr(expr ast.Expr, iota int64, known map[string]constant.Value) constant.Value {
+	value, _ := evalConstExpr(expr, iota, known)
+	return value
+}
+
+// evalConstExpr evaluates a constant expression, also returning the basic type it was
+// converted to, if any, so that ^ is computed at the width of an unsigned type.
+func evalConstExpr(expr ast.Expr, iota int64, known map[string]constant.Value) (constant.Value, string) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		value := constant.MakeFromLiteral(e.Value, e.Kind, 0)
+		if value.Kind() == constant.Unknown {
+			return nil, ""
+		}
+		return value, ""
+	case *ast.Ident:
+		switch e.Name {
+		case "iota":
+			return constant.MakeInt64(iota), ""
+		case "true", "false":
+			return constant.MakeBool(e.Name == "true"), ""
+		}
+		return known[e.Name], ""
+	case *ast.ParenExpr:
+		return evalConstExpr(e.X, iota, known)
+	case *ast.CallExpr:
+		if len(e.Args) != 1 || e.Ellipsis.IsValid() {
+			return nil, ""
+		}
+		x, xType := evalConstExpr(e.Args[0], iota, known)
+		if x == nil {
+			return nil, ""
+		}
+		return evalConstCall(e.Fun, x, xType)
+	case *ast.UnaryExpr:
+		x, xType := evalConstExpr(e.X, iota, known)
+		if x == nil {
+			return nil, ""
+		}
+		return evalUnaryOp(e.Op, x, unsignedBits[xType]), xType
+	case *ast.BinaryExpr:
+		x, xType := evalConstExpr(e.X, iota, known)
+		y, yType := evalConstExpr(e.Y, iota, known)
+		if x == nil || y == nil {
+			return nil, ""
+		}
+		if xType == "" && e.Op != token.SHL && e.Op != token.SHR {
+			xType = yType
+		}
+		return evalBinaryOp(x, e.Op, y), xType
+	default:
+		return nil, ""
+	}
+}
+
+// unsignedBits holds the width of each unsigned basic type.
+var unsignedBits = map[string]uint{
+	"uint": 64, "uint8": 8, "byte": 8, "uint16": 16, "uint32": 32, "uint64": 64, "uintptr": 64,
+}
+
+// evalConstCall evaluates a call in a constant expression, which is either the len builtin or
+// a conversion. Conversions to basic types convert the value, and conversions to named types,
+// such as Weekday(iota), keep it as it is. Any other builtin, such as unsafe.Sizeof, is not
+// evaluated.
+func evalConstCall(fun ast.Expr, x constant.Value, xType string) (constant.Value, string) {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		if fun.Name == "len" {
+			if x.Kind() != constant.String {
+				return nil, ""
+			}
+			return constant.MakeInt64(int64(len(constant.StringVal(x)))), ""
+		}
+		obj := types.Universe.Lookup(fun.Name)
+		if _, isBuiltin := obj.(*types.Builtin); isBuiltin {
+			return nil, ""
+		}
+		basic, isBasic := typeObjectBasic(obj)
+		if !isBasic {
+			return x, xType
+		}
+		return convertConst(x, basic), fun.Name
+	case *ast.SelectorExpr:
+		if pkg, ok := fun.X.(*ast.Ident); ok && pkg.Name == "unsafe" {
+			return nil, ""
+		}
+		return x, xType
+	default:
+		return nil, ""
+	}
+}
+
+// typeObjectBasic returns the basic type named by a universe object, if it names one.
+func typeObjectBasic(obj types.Object) (*types.Basic, bool) {
+	if _, isTypeName := obj.(*types.TypeName); !isTypeName {
+		return nil, false
+	}
+	basic, ok := obj.Type().(*types.Basic)
+	return basic, ok
+}
+
+// convertConst converts a constant to a basic type, returning nil if it is not representable.
+func convertConst(x constant.Value, basic *types.Basic) constant.Value {
+	var value constant.Value
+	switch info := basic.Info(); {
+	case info&types.IsInteger != 0:
+		value = constant.ToInt(x)
+	case info&types.IsFloat != 0:
+		value = constant.ToFloat(x)
+	case info&types.IsComplex != 0:
+		value = constant.ToComplex(x)
+	case info&types.IsString != 0:
+		if x.Kind() == constant.Int {
+			if code, ok := constant.Int64Val(x); ok {
+				return constant.MakeString(string(rune(code)))
+			}
+		}
+		value = x
+	default:
+		value = x
+	}
+	if value.Kind() == constant.Unknown || !representable(value, basic) {
+		return nil
+	}
+	return value
+}
+
+// representable reports whether the constant has the kind of values of the basic type.
+func representable(value constant.Value, basic *types.Basic) bool {
+	info := basic.Info()
+	switch value.Kind() {
+	case constant.Bool:
+		return info&types.IsBoolean != 0
+	case constant.String:
+		return info&types.IsString != 0
+	case constant.Int:
+		return info&types.IsInteger != 0
+	case constant.Float:
+		return info&types.IsFloat != 0
+	case constant.Complex:
+		return info&types.IsComplex != 0
+	}
+	return false
+}
+
+func evalUnaryOp(op token.Token, x constant.Value, prec uint) (value constant.Value) {
+	defer func() {
+		if recover() != nil {
+			value = nil
+		}
+	}()
+	return constant.UnaryOp(op, x, prec)
+}
+
+func evalBinaryOp(x constant.Value, op token.Token, y constant.Value) (value constant.Value) {
+	defer func() {
+		if recover() != nil {
+			value = nil
+		}
+	}()
+	switch op {
+	case token.SHL, token.SHR:
+		shift, ok := constant.Uint64Val(y)
+		if !ok {
+			return nil
+		}
+		return constant.Shift(x, op, uint(shift))
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return constant.MakeBool(constant.Compare(x, op, y))
+	case token.QUO:
+		if x.Kind() == constant.Int && y.Kind() == constant.Int {
+			op = token.QUO_ASSIGN
+		}
+	}
+	return constant.BinaryOp(x, op, y)
+}
+
 func NodeToCode(fset *token.FileSet, node any) string {
 	var builder strings.Builder
 	cfg := &printer.Config{Mode: printer.UseSpaces, Tabwidth: 4}
@@ -65,14 +343,12 @@ func NodeToCode(fset *token.FileSet, node any) string {
 	return builder.String()
 }
 
-func ParseFile(src string, fset *token.FileSet) *ast.File {
-	node, _ := parser.ParseFile(fset, src, nil, parser.ParseComments)
-	return node
+func ParseFile(src string, fset *token.FileSet) (*ast.File, error) {
+	return parser.ParseFile(fset, src, nil, parser.ParseComments|parser.AllErrors)
 }
 
-func ParseSource(src string, fset *token.FileSet) *ast.File {
-	node, _ := parser.ParseFile(fset, "", src, parser.ParseComments)
-	return node
+func ParseSource(name string, src any, fset *token.FileSet) (*ast.File, error) {
+	return parser.ParseFile(fset, name, src, parser.ParseComments|parser.AllErrors)
 }
 
 func FilePathExists(path string) error {
@@ -93,3 +369,146 @@ func CommentGroupToString(comment *ast.CommentGroup) string {
 	}
 	return buf.String()
 }
+
+// isGoFile reports whether a file found in a directory is scouted. Test files are skipped, as
+// the go tool does for a package, and are scouted only when passed as a file path.
+func isGoFile(name string) bool {
+	return strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go") &&
+		!strings.HasPrefix(name, ".") && !strings.HasPrefix(name, "_")
+}
+
+func skipDir(name string) bool {
+	return name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+func goFilesInDir(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	goFiles := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && isGoFile(entry.Name()) {
+			goFiles = append(goFiles, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return goFiles, nil
+}
+
+func goFilesInTree(root string) ([]string, error) {
+	goFiles := make([]string, 0, 10)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != root && skipDir(entry.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if isGoFile(entry.Name()) {
+			goFiles = append(goFiles, path)
+		}
+		return nil
+	})
+	return goFiles, err
+}
+
+func resolveDir(path string) (string, bool, error) {
+	if info, err := os.Stat(path); err == nil {
+		return path, info.IsDir(), nil
+	}
+	pkg, err := build.Import(path, ".", build.FindOnly)
+	if err != nil || pkg.Dir == "" {
+		return "", false, errors.New("an existing file, directory or package path must be passed")
+	}
+	return pkg.Dir, true, nil
+}
+
+func ResolveGoFiles(path string) ([]string, error) {
+	recursive := path == "..." || strings.HasSuffix(path, "/...")
+	if recursive {
+		path = strings.TrimSuffix(strings.TrimSuffix(path, "..."), "/")
+		if path == "" {
+			path = "."
+		}
+	}
+
+	resolved, isDir, err := resolveDir(path)
+	if err != nil {
+		return nil, err
+	}
+	if !isDir {
+		if recursive {
+			return nil, fmt.Errorf("recursive pattern must point to a directory: %s", path)
+		}
+		return []string{resolved}, nil
+	}
+
+	var goFiles []string
+	if recursive {
+		goFiles, err = goFilesInTree(resolved)
+	} else {
+		goFiles, err = goFilesInDir(resolved)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(goFiles) == 0 {
+		return nil, fmt.Errorf("no Go files were found in %s", path)
+	}
+	sort.Strings(goFiles)
+	return goFiles, nil
+}
+
+func goFilesInFS(fsys fs.FS, root string, recursive bool) ([]string, error) {
+	goFiles := make([]string, 0, 10)
+	err := fs.WalkDir(fsys, root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != root && (!recursive || skipDir(entry.Name())) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if isGoFile(entry.Name()) {
+			goFiles = append(goFiles, path)
+		}
+		return nil
+	})
+	return goFiles, err
+}
+
+func ResolveGoFilesFS(fsys fs.FS, path string) ([]string, error) {
+	recursive := path == "..." || strings.HasSuffix(path, "/...")
+	if recursive {
+		path = strings.TrimSuffix(strings.TrimSuffix(path, "..."), "/")
+	}
+	if path == "" {
+		path = "."
+	}
+
+	info, err := fs.Stat(fsys, path)
+	if err != nil {
+		return nil, errors.New("an existing file or directory path within the file system must be passed")
+	}
+	if !info.IsDir() {
+		if recursive {
+			return nil, fmt.Errorf("recursive pattern must point to a directory: %s", path)
+		}
+		return []string{path}, nil
+	}
+
+	goFiles, err := goFilesInFS(fsys, path, recursive)
+	if err != nil {
+		return nil, err
+	}
+	if len(goFiles) == 0 {
+		return nil, fmt.Errorf("no Go files were found in %s", path)
+	}
+	sort.Strings(goFiles)
+	return goFiles, nil
+}
@@ -2,10 +2,14 @@ package pkgutils
 
 import (
 	"go/ast"
+	"go/constant"
+	"go/parser"
 	"go/token"
+	"go/types"
 	"os"
 	"path/filepath"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,9 +36,9 @@ func TestMethodWithoutReceiver(t *testing.T) {
 }
 
 func TestFromEmptyMapKeysToSlice(t *testing.T) {
-	input := map[string]*int{"a": nil, "b": nil}
+	input := map[string]*int{"c": nil, "a": nil, "b": nil}
 	result := FromEmptyMapKeysToSlice(input)
-	assert.ElementsMatch(t, []string{"a", "b"}, result)
+	assert.Equal(t, []string{"a", "b", "c"}, result)
 }
 
 func TestDefaultTypeMap(t *testing.T) {
@@ -85,15 +89,21 @@ func TestParseFile(t *testing.T) {
 	assert.NoError(t, err)
 	defer os.Remove(tmpfile)
 
-	file := ParseFile(tmpfile, fset)
+	file, err := ParseFile(tmpfile, fset)
+	assert.NoError(t, err)
 	assert.Equal(t, "main", file.Name.Name)
 }
 
 func TestParseSource(t *testing.T) {
 	fset := token.NewFileSet()
 	src := `package demo; func Demo() {}`
-	file := ParseSource(src, fset)
+	file, err := ParseSource("demo.go", src, fset)
+	assert.NoError(t, err)
 	assert.Equal(t, "demo", file.Name.Name)
+	assert.Equal(t, "demo.go", fset.Position(file.Pos()).Filename)
+
+	_, err = ParseSource("demo.go", `package demo; func Demo( {}`, fset)
+	assert.Error(t, err)
 }
 
 func TestFilePathExists(t *testing.T) {
@@ -122,3 +132,138 @@ func TestCommentGroupToString(t *testing.T) {
 
 	assert.Equal(t, "", CommentGroupToString(nil))
 }
+
+func TestResolveGoFiles(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "testdata"), 0o755))
+	for _, name := range []string{"b.go", "a.go", "notes.txt", "a_test.go", filepath.Join("sub", "c.go"), filepath.Join("sub", "c_test.go"), filepath.Join("testdata", "d.go")} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("package x"), 0o644))
+	}
+
+	files, err := ResolveGoFiles(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a.go"), filepath.Join(dir, "b.go")}, files)
+
+	files, err = ResolveGoFiles(dir + "/...")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a.go"), filepath.Join(dir, "b.go"), filepath.Join(dir, "sub", "c.go")}, files)
+
+	files, err = ResolveGoFiles(filepath.Join(dir, "a.go"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a.go")}, files)
+
+	files, err = ResolveGoFiles(filepath.Join(dir, "a_test.go"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a_test.go")}, files)
+
+	_, err = ResolveGoFiles(filepath.Join(dir, "a.go") + "/...")
+	assert.Error(t, err)
+
+	_, err = ResolveGoFiles(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+
+	files, err = ResolveGoFiles("strings")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+}
+
+func TestResolveGoFilesFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.go":              {Data: []byte("package x")},
+		"notes.md":          {Data: []byte("notes")},
+		"pkg/b.go":          {Data: []byte("package pkg")},
+		"pkg/b_test.go":     {Data: []byte("package pkg")},
+		"pkg/sub/c.go":      {Data: []byte("package sub")},
+		"pkg/testdata/d.go": {Data: []byte("package d")},
+	}
+
+	files, err := ResolveGoFilesFS(fsys, ".")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.go"}, files)
+
+	files, err = ResolveGoFilesFS(fsys, "pkg/...")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pkg/b.go", "pkg/sub/c.go"}, files)
+
+	files, err = ResolveGoFilesFS(fsys, "pkg/sub/c.go")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pkg/sub/c.go"}, files)
+
+	_, err = ResolveGoFilesFS(fsys, "missing")
+	assert.Error(t, err)
+}
+
+func TestIsTypeSetTerm(t *testing.T) {
+	assert.True(t, IsTypeSetTerm(&ast.Ident{Name: "int"}))
+	assert.True(t, IsTypeSetTerm(&ast.UnaryExpr{Op: token.TILDE, X: &ast.Ident{Name: "string"}}))
+	assert.True(t, IsTypeSetTerm(&ast.BinaryExpr{Op: token.OR, X: &ast.Ident{Name: "int"}, Y: &ast.Ident{Name: "uint"}}))
+	assert.False(t, IsTypeSetTerm(&ast.Ident{Name: "comparable"}))
+	assert.False(t, IsTypeSetTerm(&ast.Ident{Name: "Reader"}))
+	assert.False(t, IsTypeSetTerm(&ast.SelectorExpr{X: &ast.Ident{Name: "io"}, Sel: &ast.Ident{Name: "Closer"}}))
+}
+
+func TestUnionTerms(t *testing.T) {
+	expr := &ast.BinaryExpr{
+		Op: token.OR,
+		X:  &ast.BinaryExpr{Op: token.OR, X: &ast.Ident{Name: "a"}, Y: &ast.Ident{Name: "b"}},
+		Y:  &ast.Ident{Name: "c"},
+	}
+	assert.Len(t, UnionTerms(expr), 3)
+}
+
+func TestFuncTypeString(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := ParseSource("", "package x; func F(a, b int, rest ...string) (n int, err error) { return }", fset)
+	assert.NoError(t, err)
+	funcDecl := file.Decls[0].(*ast.FuncDecl)
+	assert.Equal(t, "(int, int, ...string) (int, error)", FuncTypeString(fset, funcDecl.Type))
+}
+
+func TestImportInterface(t *testing.T) {
+	iface, err := ImportInterface("io", "ReadWriter")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, iface.NumMethods())
+	assert.Equal(t, "([]byte) (int, error)", SignatureString(iface.Method(0).Type().(*types.Signature)))
+
+	_, err = ImportInterface("io", "EOF")
+	assert.Error(t, err)
+	_, err = ImportInterface("io", "Missing")
+	assert.Error(t, err)
+}
+
+func TestEvalConstExpr(t *testing.T) {
+	known := map[string]constant.Value{"base": constant.MakeInt64(10)}
+	tests := []struct {
+		expr     string
+		iota     int64
+		expected string
+	}{
+		{"1 << (10 * iota)", 2, "1048576"},
+		{"base + iota", 3, "13"},
+		{"Weekday(iota)", 4, "4"},
+		{`"a" + "b"`, 0, `"ab"`},
+		{"7 / 2", 0, "3"},
+		{"iota > 1", 2, "true"},
+		{`len("abc")`, 0, "3"},
+		{"float64(1) / 3", 0, "1/3"},
+		{"int(7) / 2", 0, "3"},
+		{"^uint(0)", 0, "18446744073709551615"},
+		{"^uint8(0) >> 1", 0, "127"},
+		{"^0", 0, "-1"},
+		{"string(65)", 0, `"A"`},
+	}
+	for _, tt := range tests {
+		expr, err := parser.ParseExpr(tt.expr)
+		assert.NoError(t, err)
+		value := EvalConstExpr(expr, tt.iota, known)
+		assert.NotNil(t, value, tt.expr)
+		assert.Equal(t, tt.expected, value.ExactString(), tt.expr)
+	}
+
+	for _, unknown := range []string{"time.Second", "1 / 0", "missing + 1", "int(1.5)", `len(1)`, "real(1)", "unsafe.Sizeof(1)", "min(1, 2)"} {
+		expr, err := parser.ParseExpr(unknown)
+		assert.NoError(t, err)
+		assert.Nil(t, EvalConstExpr(expr, 0, known), unknown)
+	}
+}
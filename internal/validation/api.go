@@ -1,6 +1,9 @@
 package validation
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
 type Argument[T any] struct {
 	Name     string
@@ -49,9 +52,45 @@ func (p SlicePairToValidate[T]) falseValidate() bool {
 	return p.nonEmptySlice() && !*p.Bool.Variable
 }
 
+type ExclusionValidator interface {
+	validate() error
+}
+
+type ExclusionToValidate[T comparable] struct {
+	Exclude Argument[[]T]
+	Include Argument[[]T]
+	Bool    Argument[*bool]
+}
+
+func (p ExclusionToValidate[T]) trueMessage() error {
+	return fmt.Errorf("%s cannot be specified if %s is set to true", p.Exclude.Name, p.Bool.Name)
+}
+
+func (p ExclusionToValidate[T]) overlapMessage(entry T) error {
+	return fmt.Errorf("%v cannot be in both %s and %s", entry, p.Include.Name, p.Exclude.Name)
+}
+
+func (p ExclusionToValidate[T]) validate() error {
+	if len(p.Exclude.Variable) == 0 {
+		return nil
+	}
+
+	if p.Bool.Variable != nil && *p.Bool.Variable {
+		return p.trueMessage()
+	}
+
+	for _, entry := range p.Exclude.Variable {
+		if slices.Contains(p.Include.Variable, entry) {
+			return p.overlapMessage(entry)
+		}
+	}
+	return nil
+}
+
 type BatchConfigValidation struct {
-	SliceValidators []SliceValidator
-	Exact           bool
+	SliceValidators     []SliceValidator
+	ExclusionValidators []ExclusionValidator
+	Exact               bool
 }
 
 func (p BatchConfigValidation) exactMessage() error {
@@ -76,6 +115,12 @@ func (v BatchConfigValidation) Validate() error {
 		}
 	}
 
+	for _, validator := range v.ExclusionValidators {
+		if err := validator.validate(); err != nil {
+			return err
+		}
+	}
+
 	if !existingNonEmptySlice && v.Exact {
 		return v.exactMessage()
 	}
@@ -57,3 +57,22 @@ func TestBatchConfigValidation(t *testing.T) {
 	assert.Equal(t, exactMessage, batchvalidation.exactMessage().Error())
 	assert.NotNil(t, batchvalidation.Validate())
 }
+
+func TestExclusionToValidate(t *testing.T) {
+	noParams := true
+	exclusion := ExclusionToValidate[string]{
+		Exclude: Argument[[]string]{Name: "ExcludeReturnTypes", Variable: []string{"error"}},
+		Include: Argument[[]string]{Name: "ReturnTypes", Variable: []string{"int"}},
+		Bool:    Argument[*bool]{Name: "NoReturn", Variable: &noParams},
+	}
+	assert.Equal(t, "ExcludeReturnTypes cannot be specified if NoReturn is set to true", exclusion.validate().Error())
+
+	noParams = false
+	assert.Nil(t, exclusion.validate())
+
+	exclusion.Include.Variable = []string{"int", "error"}
+	assert.Equal(t, "error cannot be in both ReturnTypes and ExcludeReturnTypes", exclusion.validate().Error())
+
+	batchValidation := BatchConfigValidation{ExclusionValidators: []ExclusionValidator{exclusion}}
+	assert.NotNil(t, batchValidation.Validate())
+}
@@ -1,6 +1,11 @@
 package codescout
 
 import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
 	"github.com/galactixx/codescout/internal/pkgutils"
 	"github.com/galactixx/codescout/internal/validation"
 )
@@ -18,8 +23,11 @@ func astMatch[T, C nodeMatchTypes](
 	}
 }
 
-// nodeMatchTypes is a constraint for generic type parameters that can be a slice of strings or NamedType.
-type nodeMatchTypes interface{ []string | []NamedType }
+// nodeMatchTypes is a constraint for generic type parameters that can be a slice of strings,
+// NamedType or MethodSignature.
+type nodeMatchTypes interface {
+	[]string | []NamedType | []MethodSignature
+}
 
 // aSTNodeSliceMatch holds the data and logic needed to compare config-defined types with AST-derived types.
 type aSTNodeSliceMatch[T, C nodeMatchTypes] struct {
@@ -99,6 +107,20 @@ func namedTypesMatch(configTypes []NamedType, nodeTypes []NamedType) bool {
 	return true
 }
 
+// orderedNamedTypesMatch returns true if each config named type matches the node named type at
+// the same position, so the config types are the leading node types in order.
+func orderedNamedTypesMatch(configTypes []NamedType, nodeTypes []NamedType) bool {
+	if len(configTypes) > len(nodeTypes) {
+		return false
+	}
+	for idx := range configTypes {
+		if !namedTypesMatch(configTypes[idx:idx+1], nodeTypes[idx:idx+1]) {
+			return false
+		}
+	}
+	return true
+}
+
 // accessedMatch returns true if all config fields are present in the AST node fields.
 func accessedMatch(fields []string, nodeFields []string) bool {
 	nodeMap := pkgutils.DefaultTypeNilMap(nodeFields)
@@ -110,6 +132,83 @@ func accessedMatch(fields []string, nodeFields []string) bool {
 	return true
 }
 
+// excludesMatch returns true if any excluded entry is matched by the node types, checking each
+// entry on its own with the validator used for the corresponding inclusion list.
+func excludesMatch[T any, C nodeMatchTypes](excluded []T, nodeTypes C, validator func([]T, C) bool) bool {
+	for _, entry := range excluded {
+		if validator([]T{entry}, nodeTypes) {
+			return true
+		}
+	}
+	return false
+}
+
+// embedMatch returns true if the embedded type matches the configured type, with a pointer
+// embed also matching its element type.
+func embedMatch(configEmbed string, embed string) bool {
+	return configEmbed == embed || configEmbed == strings.TrimPrefix(embed, "*")
+}
+
+// embedsMatch returns true if every configured embed matches a distinct embedded type.
+func embedsMatch(configEmbeds []string, embeds []string) bool {
+	used := make([]bool, len(embeds))
+	for _, configEmbed := range configEmbeds {
+		found := false
+		for idx, embed := range embeds {
+			if !used[idx] && embedMatch(configEmbed, embed) {
+				used[idx] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// embedsExcluded returns true if any embedded type matches one of the excluded types.
+func embedsExcluded(excludedEmbeds []string, embeds []string) bool {
+	for _, excluded := range excludedEmbeds {
+		for _, embed := range embeds {
+			if embedMatch(excluded, embed) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tagFilterMatch returns true if the field satisfies the tag filter.
+func tagFilterMatch(filter TagFilter, field NamedType) bool {
+	if filter.Field != "" && filter.Field != field.Name {
+		return false
+	}
+	value, hasKey := field.StructTag().Lookup(filter.Key)
+	if filter.Missing {
+		return !hasKey
+	}
+	return hasKey && (filter.Value == "" || filter.Value == value)
+}
+
+// tagFiltersMatch returns true if every tag filter is satisfied by at least one field.
+func tagFiltersMatch(filters []TagFilter, fields []NamedType) bool {
+	for _, filter := range filters {
+		matched := false
+		for _, field := range fields {
+			if tagFilterMatch(filter, field) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
 // namedTypesMapOfTypes creates a map from type string to int from a list of NamedTypes.
 func namedTypesMapOfTypes(namedTypes []NamedType) map[string]int {
 	var parameterTypes []string
@@ -127,3 +226,107 @@ func namedTypesMap(namedTypes []NamedType) map[string]string {
 	}
 	return parameters
 }
+
+// signatureMatch returns true if the method signature from the config matches the node signature,
+// comparing parameters and return types exactly when exact is set.
+func signatureMatch(config MethodSignature, node MethodSignature, exact bool) bool {
+	if config.Name != "" && config.Name != node.Name {
+		return false
+	}
+	matchParams := astMatch(config.ParamTypes, node.ParamTypes, exact && len(config.ParamTypes) > 0, nil, namedTypesMatch)
+	matchReturn := astMatch(config.ReturnTypes, node.ReturnTypes, exact && len(config.ReturnTypes) > 0, nil, returnMatch)
+	return matchParams.validate() && matchReturn.validate()
+}
+
+// methodSignaturesMatch returns a validator checking that every config signature matches a
+// distinct method signature from the AST node.
+func methodSignaturesMatch(exact bool) func(configTypes []MethodSignature, nodeTypes []MethodSignature) bool {
+	return func(configTypes []MethodSignature, nodeTypes []MethodSignature) bool {
+		used := make([]bool, len(nodeTypes))
+		for _, configSignature := range configTypes {
+			found := false
+			for idx, nodeSignature := range nodeTypes {
+				if !used[idx] && signatureMatch(configSignature, nodeSignature, exact) {
+					used[idx] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PatternMode selects how name patterns such as NamePattern and ReceiverPattern are interpreted.
+type PatternMode string
+
+const (
+	// GlobPattern matches names against shell-style wildcards such as "Test*", the default.
+	GlobPattern PatternMode = "glob"
+	// RegexPattern matches names against regular expressions such as "^Handle.*".
+	RegexPattern PatternMode = "regex"
+)
+
+// namePatterns holds the regular expressions of a config's name patterns, compiled once when
+// the config is validated and kept by its inspector, so they live only as long as the scout.
+type namePatterns map[string]*regexp.Regexp
+
+// compilePatterns returns an error if the mode is unknown or any pattern is malformed, and
+// otherwise the compiled patterns, which are empty unless the mode is RegexPattern.
+func compilePatterns(mode PatternMode, patterns ...string) (namePatterns, error) {
+	if mode != "" && mode != GlobPattern && mode != RegexPattern {
+		return nil, fmt.Errorf("PatternMode must be one of: %s, %s", GlobPattern, RegexPattern)
+	}
+	compiled := make(namePatterns)
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		var err error
+		if mode == RegexPattern {
+			compiled[pattern], err = regexp.Compile(pattern)
+		} else {
+			_, err = path.Match(pattern, "")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern %q: %w", patternModeOrDefault(mode), pattern, err)
+		}
+	}
+	return compiled, nil
+}
+
+// patternModeOrDefault returns the mode, defaulting to GlobPattern when unset.
+func patternModeOrDefault(mode PatternMode) PatternMode {
+	if mode == "" {
+		return GlobPattern
+	}
+	return mode
+}
+
+// patternMatch returns true if the value matches the pattern, or if no pattern was given. A
+// regular expression missing from the compiled patterns is compiled for this match alone.
+func (p namePatterns) patternMatch(pattern string, mode PatternMode, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	if mode == RegexPattern {
+		compiled, ok := p[pattern]
+		if !ok {
+			var err error
+			if compiled, err = regexp.Compile(pattern); err != nil {
+				return false
+			}
+		}
+		return compiled.MatchString(value)
+	}
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
+
+// nameMatch returns true if the value equals the exact name, when given, and matches the pattern.
+func (p namePatterns) nameMatch(name string, pattern string, mode PatternMode, value string) bool {
+	return !(name != "" && name != value) && p.patternMatch(pattern, mode, value)
+}
@@ -85,3 +85,68 @@ func TestNamedTypesMap(t *testing.T) {
 	assert.True(t, namedTypesMapping["Age"] == "int")
 	assert.Len(t, namedTypesMapping, 2)
 }
+
+func TestMethodSignaturesMatch(t *testing.T) {
+	nodeSignatures := []MethodSignature{
+		{Name: "Read", ParamTypes: []NamedType{{Name: "p", Type: "[]byte"}}, ReturnTypes: []string{"int", "error"}},
+		{Name: "Close", ReturnTypes: []string{"error"}},
+	}
+	assert.True(t, methodSignaturesMatch(false)([]MethodSignature{{Name: "Close"}}, nodeSignatures))
+	assert.True(t, methodSignaturesMatch(false)([]MethodSignature{{ReturnTypes: []string{"int"}}}, nodeSignatures))
+	assert.False(t, methodSignaturesMatch(true)([]MethodSignature{{Name: "Read", ReturnTypes: []string{"int"}}}, nodeSignatures))
+	assert.False(t, methodSignaturesMatch(false)([]MethodSignature{{Name: "Close"}, {Name: "Close"}}, nodeSignatures))
+}
+
+func TestPatternMatch(t *testing.T) {
+	var patterns namePatterns
+	assert.True(t, patterns.patternMatch("", GlobPattern, "Anything"))
+	assert.True(t, patterns.patternMatch("Test*", GlobPattern, "TestScout"))
+	assert.True(t, patterns.patternMatch("Test*", "", "TestScout"))
+	assert.False(t, patterns.patternMatch("Test*", GlobPattern, "BenchmarkScout"))
+	assert.True(t, patterns.patternMatch("^Handle.*", RegexPattern, "HandleRequest"))
+	assert.False(t, patterns.patternMatch("^Handle.*", RegexPattern, "handleRequest"))
+	assert.False(t, patterns.patternMatch("(", RegexPattern, "("))
+
+	assert.True(t, patterns.nameMatch("Greet", "G*", GlobPattern, "Greet"))
+	assert.False(t, patterns.nameMatch("Greet", "F*", GlobPattern, "Greet"))
+}
+
+func TestCompilePatterns(t *testing.T) {
+	patterns, err := compilePatterns("", "Test*", "")
+	assert.NoError(t, err)
+	assert.Empty(t, patterns)
+
+	patterns, err = compilePatterns(RegexPattern, "^Handle.*", "")
+	assert.NoError(t, err)
+	assert.Len(t, patterns, 1)
+	assert.True(t, patterns.patternMatch("^Handle.*", RegexPattern, "HandleRequest"))
+
+	_, err = compilePatterns(GlobPattern, "[a-")
+	assert.Error(t, err)
+	_, err = compilePatterns(RegexPattern, "(")
+	assert.Error(t, err)
+	_, err = compilePatterns("fuzzy", "Test*")
+	assert.Error(t, err)
+}
+
+func TestTagFiltersMatch(t *testing.T) {
+	fields := []NamedType{
+		{Name: "ID", Type: "int", Tag: `json:"id" db:"id"`},
+		{Name: "Secret", Type: "string", Tag: `json:"-"`},
+	}
+	assert.True(t, tagFiltersMatch(nil, fields))
+	assert.True(t, tagFiltersMatch([]TagFilter{{Key: "json", Value: "-"}}, fields))
+	assert.True(t, tagFiltersMatch([]TagFilter{{Key: "db", Missing: true}}, fields))
+	assert.False(t, tagFiltersMatch([]TagFilter{{Key: "db", Missing: true, Field: "ID"}}, fields))
+	assert.False(t, tagFiltersMatch([]TagFilter{{Key: "json", Value: "secret"}}, fields))
+}
+
+func TestEmbedsMatch(t *testing.T) {
+	embeds := []string{"*Base", "sync.Mutex"}
+	assert.True(t, embedsMatch([]string{"Base"}, embeds))
+	assert.True(t, embedsMatch([]string{"*Base", "sync.Mutex"}, embeds))
+	assert.False(t, embedsMatch([]string{"Mutex"}, embeds))
+	assert.False(t, embedsMatch([]string{"Base", "Base"}, embeds))
+	assert.True(t, embedsExcluded([]string{"sync.Mutex"}, embeds))
+	assert.False(t, embedsExcluded([]string{"sync.RWMutex"}, embeds))
+}
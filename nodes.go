@@ -4,13 +4,14 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"strconv"
 	"strings"
 
 	"github.com/galactixx/codescout/internal/pkgutils"
 )
 
 // fieldListToNamedTypes converts a list of AST fields to a slice of NamedType structs,
-// each containing the name and type of the field.
+// each containing the name, type and any struct tag of the field.
 func fieldListToNamedTypes(fields *ast.FieldList, fset *token.FileSet) []NamedType {
 	fieldList := make([]NamedType, 0)
 	if fields == nil {
@@ -18,14 +19,126 @@ func fieldListToNamedTypes(fields *ast.FieldList, fset *token.FileSet) []NamedTy
 	}
 
 	for _, field := range fields.List {
+		tag := fieldTag(field)
 		for _, name := range field.Names {
-			named := NamedType{Name: name.Name, Type: pkgutils.NodeToCode(fset, field.Type)}
+			named := NamedType{Name: name.Name, Type: pkgutils.NodeToCode(fset, field.Type), Tag: tag}
 			fieldList = append(fieldList, named)
 		}
 	}
 	return fieldList
 }
 
+// structFieldsToNamedTypes converts the fields of a struct to a slice of NamedType structs,
+// including embedded fields under their implicit field name.
+func structFieldsToNamedTypes(fields *ast.FieldList, fset *token.FileSet) []NamedType {
+	fieldList := make([]NamedType, 0)
+	if fields == nil {
+		return fieldList
+	}
+
+	for _, field := range fields.List {
+		tag := fieldTag(field)
+		fieldType := pkgutils.NodeToCode(fset, field.Type)
+		if len(field.Names) == 0 {
+			embedded := NamedType{Name: embeddedFieldName(field.Type), Type: fieldType, Tag: tag, Embedded: true}
+			fieldList = append(fieldList, embedded)
+			continue
+		}
+		for _, name := range field.Names {
+			fieldList = append(fieldList, NamedType{Name: name.Name, Type: fieldType, Tag: tag})
+		}
+	}
+	return fieldList
+}
+
+// embeddedFieldName returns the implicit field name of an embedded type, e.g. "Mutex" for "*sync.Mutex".
+func embeddedFieldName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.StarExpr:
+		return embeddedFieldName(expr.X)
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	case *ast.IndexExpr:
+		return embeddedFieldName(expr.X)
+	case *ast.IndexListExpr:
+		return embeddedFieldName(expr.X)
+	default:
+		return ""
+	}
+}
+
+// fieldTag returns the unquoted tag of a struct field, or an empty string if it has none.
+func fieldTag(field *ast.Field) string {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return tag
+}
+
+// fieldListToTypes converts a list of AST fields to a slice of their type strings.
+func fieldListToTypes(fields *ast.FieldList, fset *token.FileSet) []string {
+	types := make([]string, 0, 5)
+	if fields == nil {
+		return types
+	}
+	for _, field := range fields.List {
+		if field.Type != nil {
+			types = append(types, pkgutils.NodeToCode(fset, field.Type))
+		}
+	}
+	return types
+}
+
+// typeParamsSignature appends any generic type parameters and their constraints to the
+// given name, e.g. "Map[K comparable, V any]".
+func typeParamsSignature(name string, typeParams *ast.FieldList, fset *token.FileSet) string {
+	if typeParams == nil || len(typeParams.List) == 0 {
+		return name
+	}
+	params := make([]string, 0, len(typeParams.List))
+	for _, field := range typeParams.List {
+		names := make([]string, 0, len(field.Names))
+		for _, paramName := range field.Names {
+			names = append(names, paramName.Name)
+		}
+		params = append(params, strings.Join(names, ", ")+" "+pkgutils.NodeToCode(fset, field.Type))
+	}
+	return name + "[" + strings.Join(params, ", ") + "]"
+}
+
+// typeParamsToNamedTypes converts a type parameter list into NamedType structs, each holding
+// the name of a type parameter and its constraint as the type.
+func typeParamsToNamedTypes(typeParams *ast.FieldList, fset *token.FileSet) []NamedType {
+	return fieldListToNamedTypes(typeParams, fset)
+}
+
+// receiverTypeParams returns the names of the type parameters of a generic receiver type
+// expression, e.g. "K" and "V" for "*Map[K, V]".
+func receiverTypeParams(expr ast.Expr) []string {
+	params := make([]string, 0)
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeParams(expr.X)
+	case *ast.IndexExpr:
+		if ident, ok := expr.Index.(*ast.Ident); ok {
+			params = append(params, ident.Name)
+		}
+	case *ast.IndexListExpr:
+		for _, index := range expr.Indices {
+			if ident, ok := index.(*ast.Ident); ok {
+				params = append(params, ident.Name)
+			}
+		}
+	}
+	return params
+}
+
 // NodeInfo provides a generic interface for inspecting code entities.
 type NodeInfo interface {
 	Code() string
@@ -42,14 +155,44 @@ type BaseNode struct {
 	Path string
 	// Line number where the element starts
 	Line int
-	// Number of characters from the start of the file to the element
+	// Column where the element starts, counted in bytes from 1
 	Characters int
 	// Whether the element is exported (starts with uppercase letter)
 	Exported bool
 	// Leading comment associated with the element
 	Comment string
+	// Range of the whole declaration, without its doc comment
+	Range Range
+	// Range of the doc comment, zero when the element has none
+	DocRange Range
+	// Range of the signature: the declaration up to its body for functions and methods, the
+	// name and type parameters for types, zero for constants and variables
+	SignatureRange Range
+	// Range of the body: the braces of a function, method, struct or interface, or the
+	// underlying type of a type declaration; zero when the element has none
+	BodyRange Range
+}
+
+// Position is a location in a source file.
+type Position struct {
+	// Line number, counted from 1
+	Line int `json:"line"`
+	// Column, counted in bytes from 1
+	Column int `json:"column"`
+	// Byte offset from the start of the file, counted from 0
+	Offset int `json:"offset"`
 }
 
+// Range is a span of a source file, from Start up to but not including End, so
+// src[r.Start.Offset:r.End.Offset] are the bytes it covers.
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// IsZero returns true if the range is not set.
+func (r Range) IsZero() bool { return r == Range{} }
+
 // StructNode represents a Go struct declaration in the AST.
 type StructNode struct {
 	// Node contains metadata such as name, path, line number, etc.
@@ -57,6 +200,9 @@ type StructNode struct {
 	// Methods holds all methods associated with this struct
 	Methods []*MethodNode
 
+	promotedFields  []NamedType
+	promotedMethods []string
+
 	node    *ast.StructType
 	spec    *ast.TypeSpec
 	genNode *ast.GenDecl
@@ -76,7 +222,27 @@ func (s StructNode) PrintComments() { fmt.Println(s.Comments()) }
 func (s StructNode) Name() string { return s.Node.Name }
 
 // Fields extracts all named fields from the struct definition.
-func (s StructNode) Fields() []NamedType { return fieldListToNamedTypes(s.node.Fields, s.fset) }
+func (s StructNode) Fields() []NamedType { return structFieldsToNamedTypes(s.node.Fields, s.fset) }
+
+// Embeds returns the types embedded in the struct, e.g. "sync.Mutex" or "*Base".
+func (s StructNode) Embeds() []string {
+	embeds := make([]string, 0)
+	for _, field := range s.Fields() {
+		if field.Embedded {
+			embeds = append(embeds, field.Type)
+		}
+	}
+	return embeds
+}
+
+// PromotedFields returns the fields promoted from structs embedded at any depth, limited to
+// structs declared in the scouted code. Fields shadowed by a shallower field or method, or
+// declared more than once at the same depth, are not promoted.
+func (s StructNode) PromotedFields() []NamedType { return s.promotedFields }
+
+// PromotedMethods returns the names of methods promoted from structs embedded at any depth,
+// following the same rules as PromotedFields.
+func (s StructNode) PromotedMethods() []string { return s.promotedMethods }
 
 // Comments returns documentation comments associated with the struct declaration.
 func (s StructNode) Comments() string { return pkgutils.CommentGroupToString(s.genNode.Doc) }
@@ -89,21 +255,427 @@ func (s StructNode) Body() string {
 	return structFields
 }
 
-// Signature returns the struct name along with any generic type parameters.
+// Signature returns the struct name along with any generic type parameters and their constraints.
 func (s StructNode) Signature() string {
-	signature := s.Node.Name
-	if s.spec.TypeParams == nil {
-		return signature
+	return typeParamsSignature(s.Node.Name, s.spec.TypeParams, s.fset)
+}
+
+// TypeParams returns the struct's type parameters, each with its constraint as the type.
+func (s StructNode) TypeParams() []NamedType {
+	return typeParamsToNamedTypes(s.spec.TypeParams, s.fset)
+}
+
+// IsGeneric reports whether the struct declares type parameters.
+func (s StructNode) IsGeneric() bool { return len(s.TypeParams()) > 0 }
+
+// InterfaceNode represents a Go interface declaration in the AST.
+type InterfaceNode struct {
+	// Node contains metadata such as name, path, line number, etc.
+	Node    BaseNode
+	node    *ast.InterfaceType
+	spec    *ast.TypeSpec
+	genNode *ast.GenDecl
+	fset    *token.FileSet
+}
+
+// Code returns the source code representation of the interface declaration.
+func (i InterfaceNode) Code() string { return pkgutils.NodeToCode(i.fset, i.genNode) }
+
+// PrintNode prints the full code of the interface.
+func (i InterfaceNode) PrintNode() { fmt.Println(i.Code()) }
+
+// PrintComments prints comments associated with the interface.
+func (i InterfaceNode) PrintComments() { fmt.Println(i.Comments()) }
+
+// Name returns the name of the interface.
+func (i InterfaceNode) Name() string { return i.Node.Name }
+
+// Comments returns documentation comments associated with the interface declaration.
+func (i InterfaceNode) Comments() string { return pkgutils.CommentGroupToString(i.genNode.Doc) }
+
+// Signature returns the interface name along with any generic type parameters and their constraints.
+func (i InterfaceNode) Signature() string {
+	return typeParamsSignature(i.Node.Name, i.spec.TypeParams, i.fset)
+}
+
+// TypeParams returns the interface's type parameters, each with its constraint as the type.
+func (i InterfaceNode) TypeParams() []NamedType {
+	return typeParamsToNamedTypes(i.spec.TypeParams, i.fset)
+}
+
+// Body returns the string representation of the interface's elements only.
+func (i InterfaceNode) Body() string {
+	interfaceBody := pkgutils.NodeToCode(i.fset, i.node)
+	interfaceBody = strings.Replace(interfaceBody, "interface", "", 1)
+	interfaceBody = strings.TrimSpace(interfaceBody)
+	return interfaceBody
+}
+
+// Methods returns the signatures of all methods declared directly by the interface.
+func (i InterfaceNode) Methods() []MethodSignature {
+	methods := make([]MethodSignature, 0, len(i.node.Methods.List))
+	for _, field := range i.node.Methods.List {
+		funcType, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			continue
+		}
+		methods = append(methods, MethodSignature{
+			Name:        field.Names[0].Name,
+			ParamTypes:  fieldListToNamedTypes(funcType.Params, i.fset),
+			ReturnTypes: fieldListToTypes(funcType.Results, i.fset),
+		})
 	}
+	return methods
+}
 
-	var params []string
-	for _, field := range s.spec.TypeParams.List {
-		for _, name := range field.Names {
-			params = append(params, name.Name)
+// Embeds returns the names of all interfaces embedded in the interface.
+func (i InterfaceNode) Embeds() []string {
+	embeds := make([]string, 0, 5)
+	for _, field := range i.node.Methods.List {
+		if len(field.Names) == 0 && !pkgutils.IsTypeSetTerm(field.Type) {
+			embeds = append(embeds, pkgutils.NodeToCode(i.fset, field.Type))
+		}
+	}
+	return embeds
+}
+
+// TypeSet returns the type-set constraint terms of the interface, e.g. "~int" or "string".
+func (i InterfaceNode) TypeSet() []string {
+	terms := make([]string, 0, 5)
+	for _, field := range i.node.Methods.List {
+		if len(field.Names) == 0 && pkgutils.IsTypeSetTerm(field.Type) {
+			for _, term := range pkgutils.UnionTerms(field.Type) {
+				terms = append(terms, pkgutils.NodeToCode(i.fset, term))
+			}
+		}
+	}
+	return terms
+}
+
+// ImplementsNode represents a named type whose method set satisfies an interface.
+type ImplementsNode struct {
+	// Node contains metadata such as name, path, line number, etc.
+	Node BaseNode
+	// Interface is the name of the satisfied interface
+	Interface string
+	// PointerReceiver is true if only the pointer type *T satisfies the interface
+	PointerReceiver bool
+	// Methods holds all methods declared on the type
+	Methods  []*MethodNode
+	promoted map[string]promotedMethod
+	spec     *ast.TypeSpec
+	genNode  *ast.GenDecl
+	fset     *token.FileSet
+}
+
+// promotedMethod is a method promoted to a struct from one of its embedded fields.
+type promotedMethod struct {
+	signature string
+	// pointerOnly is set if the method is only in the method set of *T, as for a pointer
+	// receiver method of an embedded field not reached through an embedded pointer.
+	pointerOnly bool
+}
+
+// Code returns the source code representation of the type declaration.
+func (i ImplementsNode) Code() string { return pkgutils.NodeToCode(i.fset, i.genNode) }
+
+// PrintNode prints the full code of the type.
+func (i ImplementsNode) PrintNode() { fmt.Println(i.Code()) }
+
+// PrintComments prints comments associated with the type.
+func (i ImplementsNode) PrintComments() { fmt.Println(i.Comments()) }
+
+// Name returns the name of the type.
+func (i ImplementsNode) Name() string { return i.Node.Name }
+
+// Comments returns documentation comments associated with the type declaration.
+func (i ImplementsNode) Comments() string { return pkgutils.CommentGroupToString(i.genNode.Doc) }
+
+// Receiver returns the receiver form needed to satisfy the interface, "T" or "*T".
+func (i ImplementsNode) Receiver() string {
+	if i.PointerReceiver {
+		return "*" + i.Node.Name
+	}
+	return i.Node.Name
+}
+
+// methodSet returns the signatures of the methods in the method set of T, or of *T if pointer is
+// set, including the methods promoted from embedded fields.
+func (i ImplementsNode) methodSet(pointer bool) map[string]string {
+	methods := make(map[string]string)
+	for _, method := range i.Methods {
+		if pointer || !method.HasPointerReceiver() {
+			methods[method.Name()] = pkgutils.FuncTypeString(i.fset, method.CallableOps.node.Type)
+		}
+	}
+	for name, method := range i.promoted {
+		if pointer || !method.pointerOnly {
+			methods[name] = method.signature
+		}
+	}
+	return methods
+}
+
+// methodTypes returns the signatures of the methods declared directly by the interface, keyed by name.
+func (i InterfaceNode) methodTypes() map[string]string {
+	methods := make(map[string]string)
+	for _, field := range i.node.Methods.List {
+		if funcType, ok := field.Type.(*ast.FuncType); ok && len(field.Names) > 0 {
+			methods[field.Names[0].Name] = pkgutils.FuncTypeString(i.fset, funcType)
+		}
+	}
+	return methods
+}
+
+// TypeNode represents any declared type, including aliases and non-struct defined types.
+type TypeNode struct {
+	// Node contains metadata such as name, path, line number, etc.
+	Node BaseNode
+	// Methods holds all methods declared on this type
+	Methods []*MethodNode
+	spec    *ast.TypeSpec
+	genNode *ast.GenDecl
+	fset    *token.FileSet
+}
+
+// Code returns the source code representation of the type declaration.
+func (t TypeNode) Code() string {
+	spec := *t.spec
+	spec.Doc, spec.Comment = nil, nil
+	return pkgutils.NodeToCode(t.fset, &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{&spec}})
+}
+
+// PrintNode prints the full code of the type.
+func (t TypeNode) PrintNode() { fmt.Println(t.Code()) }
+
+// PrintComments prints comments associated with the type.
+func (t TypeNode) PrintComments() { fmt.Println(t.Comments()) }
+
+// Name returns the name of the type.
+func (t TypeNode) Name() string { return t.Node.Name }
+
+// Comments returns documentation comments associated with the type declaration.
+func (t TypeNode) Comments() string {
+	if t.spec.Doc != nil {
+		return pkgutils.CommentGroupToString(t.spec.Doc)
+	}
+	return pkgutils.CommentGroupToString(t.genNode.Doc)
+}
+
+// Signature returns the type name along with any generic type parameters and their constraints.
+func (t TypeNode) Signature() string {
+	return typeParamsSignature(t.Node.Name, t.spec.TypeParams, t.fset)
+}
+
+// TypeParams returns the type's type parameters, each with its constraint as the type.
+func (t TypeNode) TypeParams() []NamedType { return typeParamsToNamedTypes(t.spec.TypeParams, t.fset) }
+
+// Underlying returns the source of the type expression the type is defined as, or aliases.
+func (t TypeNode) Underlying() string { return pkgutils.NodeToCode(t.fset, t.spec.Type) }
+
+// IsAlias reports whether the type is an alias declared with "type X = Y".
+func (t TypeNode) IsAlias() bool { return t.spec.Assign.IsValid() }
+
+// MethodNames returns the names of all methods declared on the type.
+func (t TypeNode) MethodNames() []string {
+	names := make([]string, 0, len(t.Methods))
+	for _, method := range t.Methods {
+		names = append(names, method.Name())
+	}
+	return names
+}
+
+// Kind returns the kind of type expression the type is defined as, or KindAlias for aliases.
+func (t TypeNode) Kind() TypeKind {
+	if t.IsAlias() {
+		return KindAlias
+	}
+	switch expr := t.spec.Type.(type) {
+	case *ast.StructType:
+		return KindStruct
+	case *ast.InterfaceType:
+		return KindInterface
+	case *ast.FuncType:
+		return KindFunc
+	case *ast.ArrayType:
+		if expr.Len == nil {
+			return KindSlice
+		}
+		return KindArray
+	case *ast.MapType:
+		return KindMap
+	case *ast.ChanType:
+		return KindChan
+	case *ast.StarExpr:
+		return KindPointer
+	case *ast.Ident:
+		if pkgutils.IsTypeSetTerm(expr) {
+			return KindBasic
+		}
+		return KindNamed
+	default:
+		return KindNamed
+	}
+}
+
+// ConstValue represents a single constant, including the members of an iota enum group.
+type ConstValue struct {
+	// Name of the constant
+	Name string
+	// Declared type, inherited from the previous spec when it is implicitly repeated
+	Type string
+	// Source expression of the value, inherited from the previous spec when implicitly repeated
+	Value string
+	// Evaluated value of the constant, empty if it could not be evaluated
+	Evaluated string
+	// Whether the value was written explicitly rather than implicitly repeated
+	Explicit bool
+	// Doc or trailing comment associated with the constant
+	Comment string
+}
+
+// ConstNode represents a package-level constant, or a whole iota enum group.
+type ConstNode struct {
+	// Node contains metadata such as name, path, line number, etc.
+	Node BaseNode
+	// Members holds every constant of the node, one unless the node is an enum group
+	Members []ConstValue
+	isEnum  bool
+	specs   []*ast.ValueSpec
+	genNode *ast.GenDecl
+	fset    *token.FileSet
+}
+
+// Code returns the source code of the constant declaration, or the whole enum block.
+func (c ConstNode) Code() string {
+	if c.isEnum {
+		return pkgutils.NodeToCode(c.fset, c.genNode)
+	}
+	specs := make([]ast.Spec, 0, len(c.specs))
+	for _, spec := range c.specs {
+		specs = append(specs, valueSpecWithoutComments(spec))
+	}
+	return pkgutils.NodeToCode(c.fset, &ast.GenDecl{Tok: token.CONST, Specs: specs})
+}
+
+// PrintNode prints the full code of the constant.
+func (c ConstNode) PrintNode() { fmt.Println(c.Code()) }
+
+// PrintComments prints comments associated with the constant.
+func (c ConstNode) PrintComments() { fmt.Println(c.Comments()) }
+
+// Name returns the name of the constant, or the enum type name for enum groups.
+func (c ConstNode) Name() string { return c.Node.Name }
+
+// Comments returns documentation comments associated with the constant declaration.
+func (c ConstNode) Comments() string {
+	if !c.isEnum && len(c.specs) == 1 && c.specs[0].Doc != nil {
+		return pkgutils.CommentGroupToString(c.specs[0].Doc)
+	}
+	return pkgutils.CommentGroupToString(c.genNode.Doc)
+}
+
+// IsEnum reports whether the node is an iota enum group.
+func (c ConstNode) IsEnum() bool { return c.isEnum }
+
+// Type returns the declared type shared by all members, or an empty string if there is none.
+func (c ConstNode) Type() string { return sharedConstType(c.Members) }
+
+// firstNamedMember returns the name of the first member that is not blank, or "_" if all are.
+func firstNamedMember(members []ConstValue) string {
+	for _, member := range members {
+		if member.Name != "_" {
+			return member.Name
 		}
 	}
-	signature += "[" + strings.Join(params, ", ") + "]"
-	return signature
+	return members[0].Name
+}
+
+// sharedConstType returns the declared type shared by all constants, or an empty string if there is none.
+func sharedConstType(members []ConstValue) string {
+	if len(members) == 0 {
+		return ""
+	}
+	constType := members[0].Type
+	for _, member := range members[1:] {
+		if member.Type != constType {
+			return ""
+		}
+	}
+	return constType
+}
+
+// HasValue reports whether every member has its value written explicitly.
+func (c ConstNode) HasValue() bool {
+	for _, member := range c.Members {
+		if !member.Explicit {
+			return false
+		}
+	}
+	return true
+}
+
+// VarNode represents a package-level variable.
+type VarNode struct {
+	// Node contains metadata such as name, path, line number, etc.
+	Node    BaseNode
+	index   int
+	spec    *ast.ValueSpec
+	genNode *ast.GenDecl
+	fset    *token.FileSet
+}
+
+// Code returns the source code of the variable's declaration.
+func (v VarNode) Code() string {
+	return pkgutils.NodeToCode(v.fset, &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{valueSpecWithoutComments(v.spec)}})
+}
+
+// valueSpecWithoutComments returns a copy of the value spec without its doc and trailing comments,
+// so it can be printed outside of its original declaration block.
+func valueSpecWithoutComments(spec *ast.ValueSpec) *ast.ValueSpec {
+	specCopy := *spec
+	specCopy.Doc, specCopy.Comment = nil, nil
+	return &specCopy
+}
+
+// PrintNode prints the full code of the variable.
+func (v VarNode) PrintNode() { fmt.Println(v.Code()) }
+
+// PrintComments prints comments associated with the variable.
+func (v VarNode) PrintComments() { fmt.Println(v.Comments()) }
+
+// Name returns the name of the variable.
+func (v VarNode) Name() string { return v.Node.Name }
+
+// Comments returns documentation comments associated with the variable declaration.
+func (v VarNode) Comments() string {
+	if v.spec.Doc != nil {
+		return pkgutils.CommentGroupToString(v.spec.Doc)
+	}
+	return pkgutils.CommentGroupToString(v.genNode.Doc)
+}
+
+// Type returns the declared type of the variable, or an empty string if it is inferred.
+func (v VarNode) Type() string {
+	if v.spec.Type == nil {
+		return ""
+	}
+	return pkgutils.NodeToCode(v.fset, v.spec.Type)
+}
+
+// HasValue reports whether the variable is assigned a value in its declaration.
+func (v VarNode) HasValue() bool { return len(v.spec.Values) > 0 }
+
+// Value returns the source expression assigned to the variable, or an empty string if there is none.
+func (v VarNode) Value() string {
+	switch {
+	case len(v.spec.Values) == len(v.spec.Names):
+		return pkgutils.NodeToCode(v.fset, v.spec.Values[v.index])
+	case len(v.spec.Values) == 1:
+		return pkgutils.NodeToCode(v.fset, v.spec.Values[0])
+	default:
+		return ""
+	}
 }
 
 // MethodNode represents a method with its associated metadata and interactions.
@@ -115,6 +687,7 @@ type MethodNode struct {
 
 	fieldsAccessed map[string]*int
 	methodsCalled  map[string]*int
+	typeParams     []NamedType
 }
 
 // addMethodField registers that a struct field is accessed in this method.
@@ -140,12 +713,12 @@ func (m MethodNode) HasPointerReceiver() bool {
 	return isPointer
 }
 
-// FieldsAccessed returns a slice of field names accessed by this method.
+// FieldsAccessed returns the sorted names of the receiver fields accessed by this method.
 func (m MethodNode) FieldsAccessed() []string {
 	return pkgutils.FromEmptyMapKeysToSlice(m.fieldsAccessed)
 }
 
-// MethodsCalled returns a slice of method names called by this method.
+// MethodsCalled returns the sorted names of the receiver methods called by this method.
 func (m MethodNode) MethodsCalled() []string {
 	return pkgutils.FromEmptyMapKeysToSlice(m.methodsCalled)
 }
@@ -168,7 +741,12 @@ func (m MethodNode) ReceiverType() string {
 		return ""
 	}
 
-	structType := m.CallableOps.node.Recv.List[0].Type
+	return receiverTypeName(m.CallableOps.node.Recv.List[0].Type)
+}
+
+// receiverTypeName returns the type name of a receiver type expression, without any pointer
+// or generic type arguments.
+func receiverTypeName(structType ast.Expr) string {
 	switch expr := structType.(type) {
 	case *ast.Ident:
 		// -> m MyStruct
@@ -182,16 +760,45 @@ func (m MethodNode) ReceiverType() string {
 			// -> m *MyStruct
 			return selExpr.Name
 		default:
-			return ""
+			// -> m *MyStruct[T]
+			return receiverTypeName(expr.X)
 		}
 	case *ast.SelectorExpr:
 		// -> m pkg.MyStruct
 		return pkgutils.FormatStructName(expr)
+	case *ast.IndexExpr:
+		// -> m MyStruct[T]
+		return receiverTypeName(expr.X)
+	case *ast.IndexListExpr:
+		// -> m MyStruct[K, V]
+		return receiverTypeName(expr.X)
 	default:
 		return ""
 	}
 }
 
+// ReceiverTypeParams returns the type parameter names of a generic receiver, e.g. "T" for
+// "func (s *Stack[T]) Push".
+func (m MethodNode) ReceiverTypeParams() []string {
+	if pkgutils.MethodWithoutReceiver(m.CallableOps.node) {
+		return make([]string, 0)
+	}
+	return receiverTypeParams(m.CallableOps.node.Recv.List[0].Type)
+}
+
+// TypeParams returns the type parameters of a generic receiver, each with the constraint
+// declared by the receiver's type, e.g. {Name: "T", Type: "any"} for "func (s *Stack[T]) Push".
+// The constraint is empty when the type is not declared in the scouted package.
+func (m MethodNode) TypeParams() []NamedType {
+	if m.typeParams == nil {
+		return make([]NamedType, 0)
+	}
+	return m.typeParams
+}
+
+// IsGeneric reports whether the method belongs to a generic receiver type.
+func (m MethodNode) IsGeneric() bool { return len(m.ReceiverTypeParams()) > 0 }
+
 // ReceiverName returns the name of the receiver variable (e.g., "m" in "func (m *MyStruct) ...").
 func (m MethodNode) ReceiverName() string {
 	if pkgutils.MethodWithoutRecvList(m.CallableOps.node) {
@@ -225,6 +832,9 @@ func (f FuncNode) PrintComments() { fmt.Println(f.CallableOps.Comments()) }
 // Name returns the function name.
 func (f FuncNode) Name() string { return f.Node.Name }
 
+// IsGeneric reports whether the function declares type parameters.
+func (f FuncNode) IsGeneric() bool { return len(f.CallableOps.TypeParams()) > 0 }
+
 // CallableOps contains logic for extracting code and metadata from AST function declarations.
 type CallableOps struct {
 	node *ast.FuncDecl
@@ -255,6 +865,14 @@ func (c CallableOps) Parameters() []NamedType {
 	return fieldListToNamedTypes(c.node.Type.Params, c.fset)
 }
 
+// TypeParams returns the function's type parameters, each with its constraint as the type.
+func (c CallableOps) TypeParams() []NamedType {
+	if c.node.Type == nil {
+		return make([]NamedType, 0)
+	}
+	return typeParamsToNamedTypes(c.node.Type.TypeParams, c.fset)
+}
+
 // Code returns the full source code of the function, optionally including comments.
 func (c CallableOps) Code() string {
 	nodeOriginalDoc := c.node.Doc
@@ -291,13 +909,8 @@ func (c CallableOps) ReturnType() string {
 
 // ReturnTypes returns a slice of string representations of all return types.
 func (c CallableOps) ReturnTypes() []string {
-	returnTypes := make([]string, 0, 5)
-	if c.node.Type != nil && c.node.Type.Results != nil {
-		for _, returnType := range c.node.Type.Results.List {
-			if returnType.Type != nil {
-				returnTypes = append(returnTypes, pkgutils.NodeToCode(c.fset, returnType.Type))
-			}
-		}
+	if c.node.Type == nil {
+		return make([]string, 0, 5)
 	}
-	return returnTypes
+	return fieldListToTypes(c.node.Type.Results, c.fset)
 }
@@ -3,6 +3,7 @@ package codescout
 import (
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"testing"
 
@@ -279,3 +280,42 @@ func TestStructNode(t *testing.T) {
 	)
 	assert.Equal(t, "User", structNode.Signature())
 }
+
+func TestInterfaceNode(t *testing.T) {
+	fset := token.NewFileSet()
+	src := `package main
+
+// Store doc
+type Store[K comparable] interface {
+	io.Closer
+	~string | ~[]byte
+	Get(key K) (value []byte, err error)
+}`
+	file, _ := parser.ParseFile(fset, "", src, parser.ParseComments)
+	gen := file.Decls[0].(*ast.GenDecl)
+	spec := gen.Specs[0].(*ast.TypeSpec)
+
+	interfaceNode := InterfaceNode{
+		Node:    BaseNode{Name: "Store"},
+		node:    spec.Type.(*ast.InterfaceType),
+		spec:    spec,
+		genNode: gen,
+		fset:    fset,
+	}
+
+	assert.Equal(t, "Store", interfaceNode.Name())
+	assert.Equal(t, "Store[K comparable]", interfaceNode.Signature())
+	assert.Equal(t, "// Store doc\n", interfaceNode.Comments())
+	assert.Equal(t, []string{"io.Closer"}, interfaceNode.Embeds())
+	assert.Equal(t, []string{"~string", "~[]byte"}, interfaceNode.TypeSet())
+	assert.Equal(
+		t,
+		[]MethodSignature{{
+			Name:        "Get",
+			ParamTypes:  []NamedType{{Name: "key", Type: "K"}},
+			ReturnTypes: []string{"[]byte", "error"},
+		}},
+		interfaceNode.Methods(),
+	)
+	assert.Contains(t, interfaceNode.Code(), "type Store[K comparable] interface {")
+}
@@ -0,0 +1,62 @@
+package codescout
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// SortOrder selects the order in which matched nodes are returned.
+type SortOrder string
+
+const (
+	// PositionSort orders nodes by file path and then by position within the file, the default.
+	PositionSort SortOrder = "position"
+	// NameSort orders nodes by name, then by position.
+	NameSort SortOrder = "name"
+	// ExportedSort orders exported nodes before unexported ones, then by position.
+	ExportedSort SortOrder = "exported"
+)
+
+// validateSortOrder returns an error if the sort order is unknown.
+func validateSortOrder(order SortOrder) error {
+	if order != "" && order != PositionSort && order != NameSort && order != ExportedSort {
+		return fmt.Errorf("Sort must be one of: %s, %s, %s", PositionSort, NameSort, ExportedSort)
+	}
+	return nil
+}
+
+// comparePositions orders two nodes by file path and then by offset within the file.
+func comparePositions(a BaseNode, b BaseNode) int {
+	if pathOrder := cmp.Compare(a.Path, b.Path); pathOrder != 0 {
+		return pathOrder
+	}
+	return cmp.Compare(a.Range.Start.Offset, b.Range.Start.Offset)
+}
+
+// compareNodes orders two nodes according to the sort order, falling back to their positions.
+func compareNodes(order SortOrder, a BaseNode, b BaseNode) int {
+	switch order {
+	case NameSort:
+		if nameOrder := cmp.Compare(a.Name, b.Name); nameOrder != 0 {
+			return nameOrder
+		}
+	case ExportedSort:
+		if a.Exported != b.Exported {
+			if a.Exported {
+				return -1
+			}
+			return 1
+		}
+	}
+	return comparePositions(a, b)
+}
+
+// sortNodes sorts the nodes in place according to the sort order, keeping nodes at the same
+// position, such as the names of a single "var a, b int" spec, in declaration order.
+func sortNodes[T any](nodes []*T, order SortOrder, base func(*T) BaseNode) []*T {
+	slices.SortStableFunc(nodes, func(a *T, b *T) int {
+		return compareNodes(order, base(a), base(b))
+	})
+	return nodes
+}
@@ -1,9 +1,11 @@
 package codescout
 
 import (
+	"errors"
+	"fmt"
 	"go/token"
+	"slices"
 
-	"github.com/galactixx/codescout/internal/pkgutils"
 	"github.com/galactixx/codescout/internal/validation"
 )
 
@@ -15,16 +17,27 @@ type preScoutSetup[T any] interface {
 // funcScoutSetup holds configuration for scanning functions.
 type funcScoutSetup struct {
 	Path   string
+	Source source
 	Config FuncConfig
 }
 
-// initializeInspect validates function-related configuration and returns an inspector for FuncNode.
-//
-//lint:ignore U1000 used via interface
-func (s funcScoutSetup) initializeInspect() (inspector[FuncNode], error) {
-	// Check if the provided path exists.
-	if fileExistsErr := pkgutils.FilePathExists(s.Path); fileExistsErr != nil {
-		return nil, fileExistsErr
+// validate checks the function configuration, without resolving any files.
+func (s funcScoutSetup) validate() (namePatterns, error) {
+	// Ensure the type match mode is known and compile the name patterns, which must be well-formed.
+	typeMatchErr := validateTypeMatch(s.Config.TypeMatch)
+	if typeMatchErr != nil {
+		return nil, typeMatchErr
+	}
+
+	patterns, patternErr := compilePatterns(s.Config.PatternMode, s.Config.NamePattern)
+	if patternErr != nil {
+		return nil, patternErr
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
 	}
 
 	// Create validation rules for function parameters and return types.
@@ -38,6 +51,22 @@ func (s funcScoutSetup) initializeInspect() (inspector[FuncNode], error) {
 				Slice: validation.Arg("ReturnTypes", s.Config.ReturnTypes),
 				Bool:  validation.Arg("NoReturn", s.Config.NoReturn),
 			},
+			validation.SlicePairToValidate[NamedType]{
+				Slice: validation.Arg("TypeParams", s.Config.TypeParams),
+				Bool:  validation.Arg("NoTypeParams", s.Config.NoTypeParams),
+			},
+		},
+		ExclusionValidators: []validation.ExclusionValidator{
+			validation.ExclusionToValidate[NamedType]{
+				Exclude: validation.Arg("ExcludeParamTypes", s.Config.ExcludeParamTypes),
+				Include: validation.Arg("Types", s.Config.ParamTypes),
+				Bool:    validation.Arg("NoParams", s.Config.NoParams),
+			},
+			validation.ExclusionToValidate[string]{
+				Exclude: validation.Arg("ExcludeReturnTypes", s.Config.ExcludeReturnTypes),
+				Include: validation.Arg("ReturnTypes", s.Config.ReturnTypes),
+				Bool:    validation.Arg("NoReturn", s.Config.NoReturn),
+			},
 		},
 		Exact: s.Config.Exact,
 	}
@@ -47,12 +76,36 @@ func (s funcScoutSetup) initializeInspect() (inspector[FuncNode], error) {
 	if batchErr != nil {
 		return nil, batchErr
 	}
+	return patterns, nil
+}
+
+// initializeInspect validates function-related configuration and returns an inspector for FuncNode.
+//
+//lint:ignore U1000 used via interface
+func (s funcScoutSetup) initializeInspect() (inspector[FuncNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Validate the configuration before scouting.
+	patterns, validateErr := s.validate()
+	if validateErr != nil {
+		return nil, validateErr
+	}
 
 	// Create and return the function inspector.
+	fset := token.NewFileSet()
 	inspector := funcInspector{
-		Nodes:  []*FuncNode{},
-		Config: s.Config,
-		Base:   baseInspector{Path: s.Path, Fset: token.NewFileSet()},
+		Nodes:    []*FuncNode{},
+		Config:   s.Config,
+		patterns: patterns,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: fset, Partial: s.Config.Partial, Workers: s.Config.Workers,
+			Types: newTypeChecker(s.Config.TypeMatch, fset),
+		},
 	}
 	return &inspector, nil
 }
@@ -60,16 +113,27 @@ func (s funcScoutSetup) initializeInspect() (inspector[FuncNode], error) {
 // methodScoutSetup holds configuration for scanning methods.
 type methodScoutSetup struct {
 	Path   string
+	Source source
 	Config MethodConfig
 }
 
-// initializeInspect validates method-related configuration and returns an inspector for MethodNode.
-//
-//lint:ignore U1000 used via interface
-func (s methodScoutSetup) initializeInspect() (inspector[MethodNode], error) {
-	// Check if the provided path exists.
-	if fileExistsErr := pkgutils.FilePathExists(s.Path); fileExistsErr != nil {
-		return nil, fileExistsErr
+// validate checks the method configuration, without resolving any files.
+func (s methodScoutSetup) validate() (namePatterns, error) {
+	// Ensure the type match mode is known and compile the name patterns, which must be well-formed.
+	typeMatchErr := validateTypeMatch(s.Config.TypeMatch)
+	if typeMatchErr != nil {
+		return nil, typeMatchErr
+	}
+
+	patterns, patternErr := compilePatterns(s.Config.PatternMode, s.Config.NamePattern, s.Config.ReceiverPattern)
+	if patternErr != nil {
+		return nil, patternErr
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
 	}
 
 	// Create validation rules for method fields, methods, return types, and parameters.
@@ -91,6 +155,32 @@ func (s methodScoutSetup) initializeInspect() (inspector[MethodNode], error) {
 				Slice: validation.Arg("Types", s.Config.ParamTypes),
 				Bool:  validation.Arg("NoParams", s.Config.NoParams),
 			},
+			validation.SlicePairToValidate[NamedType]{
+				Slice: validation.Arg("TypeParams", s.Config.TypeParams),
+				Bool:  validation.Arg("NoTypeParams", s.Config.NoTypeParams),
+			},
+		},
+		ExclusionValidators: []validation.ExclusionValidator{
+			validation.ExclusionToValidate[NamedType]{
+				Exclude: validation.Arg("ExcludeParamTypes", s.Config.ExcludeParamTypes),
+				Include: validation.Arg("Types", s.Config.ParamTypes),
+				Bool:    validation.Arg("NoParams", s.Config.NoParams),
+			},
+			validation.ExclusionToValidate[string]{
+				Exclude: validation.Arg("ExcludeReturnTypes", s.Config.ExcludeReturnTypes),
+				Include: validation.Arg("ReturnTypes", s.Config.ReturnTypes),
+				Bool:    validation.Arg("NoReturn", s.Config.NoReturn),
+			},
+			validation.ExclusionToValidate[string]{
+				Exclude: validation.Arg("ExcludeFields", s.Config.ExcludeFields),
+				Include: validation.Arg("Fields", s.Config.Fields),
+				Bool:    validation.Arg("NoFields", s.Config.NoFields),
+			},
+			validation.ExclusionToValidate[string]{
+				Exclude: validation.Arg("ExcludeMethods", s.Config.ExcludeMethods),
+				Include: validation.Arg("Methods", s.Config.Methods),
+				Bool:    validation.Arg("NoMethods", s.Config.NoMethods),
+			},
 		},
 		Exact: s.Config.Exact,
 	}
@@ -100,12 +190,36 @@ func (s methodScoutSetup) initializeInspect() (inspector[MethodNode], error) {
 	if batchErr != nil {
 		return nil, batchErr
 	}
+	return patterns, nil
+}
+
+// initializeInspect validates method-related configuration and returns an inspector for MethodNode.
+//
+//lint:ignore U1000 used via interface
+func (s methodScoutSetup) initializeInspect() (inspector[MethodNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Validate the configuration before scouting.
+	patterns, validateErr := s.validate()
+	if validateErr != nil {
+		return nil, validateErr
+	}
 
 	// Create and return the method inspector.
+	fset := token.NewFileSet()
 	inspector := methodInspector{
-		Nodes:  []*MethodNode{},
-		Config: s.Config,
-		Base:   baseInspector{Path: s.Path, Fset: token.NewFileSet()},
+		Nodes:    []*MethodNode{},
+		Config:   s.Config,
+		patterns: patterns,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: fset, Partial: s.Config.Partial, Workers: s.Config.Workers,
+			Types: newTypeChecker(s.Config.TypeMatch, fset),
+		},
 	}
 	return &inspector, nil
 }
@@ -113,16 +227,34 @@ func (s methodScoutSetup) initializeInspect() (inspector[MethodNode], error) {
 // structScoutSetup holds configuration for scanning structs.
 type structScoutSetup struct {
 	Path   string
+	Source source
 	Config StructConfig
 }
 
-// initializeInspect validates struct-related configuration and returns an inspector for StructNode.
-//
-//lint:ignore U1000 used via interface
-func (s structScoutSetup) initializeInspect() (inspector[StructNode], error) {
-	// Check if the provided path exists.
-	if fileExistsErr := pkgutils.FilePathExists(s.Path); fileExistsErr != nil {
-		return nil, fileExistsErr
+// validate checks the struct configuration, without resolving any files.
+func (s structScoutSetup) validate() (namePatterns, error) {
+	// Ensure the type match mode is known and compile the name patterns, which must be well-formed.
+	typeMatchErr := validateTypeMatch(s.Config.TypeMatch)
+	if typeMatchErr != nil {
+		return nil, typeMatchErr
+	}
+
+	patterns, patternErr := compilePatterns(s.Config.PatternMode, s.Config.NamePattern)
+	if patternErr != nil {
+		return nil, patternErr
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	// Ensure every tag filter names the tag key it filters on.
+	for _, tagFilter := range s.Config.Tags {
+		if tagFilter.Key == "" {
+			return nil, errors.New("a tag key must be specified for every tag filter")
+		}
 	}
 
 	// Create validation rules for struct fields.
@@ -132,6 +264,26 @@ func (s structScoutSetup) initializeInspect() (inspector[StructNode], error) {
 				Slice: validation.Arg("FieldTypes", s.Config.FieldTypes),
 				Bool:  validation.Arg("NoFields", s.Config.NoFields),
 			},
+			validation.SlicePairToValidate[string]{
+				Slice: validation.Arg("Embeds", s.Config.Embeds),
+				Bool:  validation.Arg("NoEmbeds", s.Config.NoEmbeds),
+			},
+			validation.SlicePairToValidate[NamedType]{
+				Slice: validation.Arg("TypeParams", s.Config.TypeParams),
+				Bool:  validation.Arg("NoTypeParams", s.Config.NoTypeParams),
+			},
+		},
+		ExclusionValidators: []validation.ExclusionValidator{
+			validation.ExclusionToValidate[NamedType]{
+				Exclude: validation.Arg("ExcludeFieldTypes", s.Config.ExcludeFieldTypes),
+				Include: validation.Arg("FieldTypes", s.Config.FieldTypes),
+				Bool:    validation.Arg("NoFields", s.Config.NoFields),
+			},
+			validation.ExclusionToValidate[string]{
+				Exclude: validation.Arg("ExcludeEmbeds", s.Config.ExcludeEmbeds),
+				Include: validation.Arg("Embeds", s.Config.Embeds),
+				Bool:    validation.Arg("NoEmbeds", s.Config.NoEmbeds),
+			},
 		},
 		Exact: s.Config.Exact,
 	}
@@ -141,12 +293,361 @@ func (s structScoutSetup) initializeInspect() (inspector[StructNode], error) {
 	if batchErr != nil {
 		return nil, batchErr
 	}
+	return patterns, nil
+}
+
+// initializeInspect validates struct-related configuration and returns an inspector for StructNode.
+//
+//lint:ignore U1000 used via interface
+func (s structScoutSetup) initializeInspect() (inspector[StructNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Validate the configuration before scouting.
+	patterns, validateErr := s.validate()
+	if validateErr != nil {
+		return nil, validateErr
+	}
 
 	// Create and return the struct inspector.
+	fset := token.NewFileSet()
 	inspector := structInspector{
-		Nodes:  map[string]*StructNode{},
+		Nodes:    []*StructNode{},
+		Config:   s.Config,
+		patterns: patterns,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: fset, Partial: s.Config.Partial, Workers: s.Config.Workers,
+			Types: newTypeChecker(s.Config.TypeMatch, fset),
+		},
+	}
+	return &inspector, nil
+}
+
+// interfaceScoutSetup holds configuration for scanning interfaces.
+type interfaceScoutSetup struct {
+	Path   string
+	Source source
+	Config InterfaceConfig
+}
+
+// initializeInspect validates interface-related configuration and returns an inspector for InterfaceNode.
+//
+//lint:ignore U1000 used via interface
+func (s interfaceScoutSetup) initializeInspect() (inspector[InterfaceNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Compile the name patterns, which must be well-formed.
+	patterns, patternErr := compilePatterns(s.Config.PatternMode, s.Config.NamePattern)
+	if patternErr != nil {
+		return nil, patternErr
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	// Create validation rules for interface methods, embeds and type-set terms.
+	batchValidation := validation.BatchConfigValidation{
+		SliceValidators: []validation.SliceValidator{
+			validation.SlicePairToValidate[MethodSignature]{
+				Slice: validation.Arg("Methods", s.Config.Methods),
+				Bool:  validation.Arg("NoMethods", s.Config.NoMethods),
+			},
+			validation.SlicePairToValidate[string]{
+				Slice: validation.Arg("Embeds", s.Config.Embeds),
+				Bool:  validation.Arg("NoEmbeds", s.Config.NoEmbeds),
+			},
+			validation.SlicePairToValidate[string]{
+				Slice: validation.Arg("TypeSet", s.Config.TypeSet),
+			},
+		},
+		Exact: s.Config.Exact,
+	}
+
+	// Run batch validation and return an error if it fails.
+	batchErr := batchValidation.Validate()
+	if batchErr != nil {
+		return nil, batchErr
+	}
+
+	// Create and return the interface inspector.
+	inspector := interfaceInspector{
+		Nodes:    []*InterfaceNode{},
+		Config:   s.Config,
+		patterns: patterns,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: token.NewFileSet(), Partial: s.Config.Partial, Workers: s.Config.Workers,
+		},
+	}
+	return &inspector, nil
+}
+
+// implementsScoutSetup holds configuration for scanning interface implementations.
+type implementsScoutSetup struct {
+	Path   string
+	Source source
+	Config ImplementsConfig
+}
+
+// initializeInspect validates implements-related configuration and returns an inspector for ImplementsNode.
+//
+//lint:ignore U1000 used via interface
+func (s implementsScoutSetup) initializeInspect() (inspector[ImplementsNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// An interface must always be given to check method sets against.
+	if s.Config.Interface == "" {
+		return nil, errors.New("an interface name must be specified")
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	// Create and return the implements inspector.
+	inspector := implementsInspector{
+		Nodes:  []*ImplementsNode{},
+		Config: s.Config,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: token.NewFileSet(), Partial: s.Config.Partial, Workers: s.Config.Workers,
+		},
+	}
+	return &inspector, nil
+}
+
+// callGraphScoutSetup holds configuration for scanning the calls between functions and methods.
+type callGraphScoutSetup struct {
+	Path   string
+	Source source
+	Config CallGraphConfig
+}
+
+// initializeInspect resolves the files to scan and returns an inspector for CallEdge.
+//
+//lint:ignore U1000 used via interface
+func (s callGraphScoutSetup) initializeInspect() (inspector[CallEdge], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Create and return the call graph inspector, which always type-checks to resolve calls.
+	fset := token.NewFileSet()
+	inspector := callGraphInspector{
+		Nodes:  []*CallEdge{},
 		Config: s.Config,
-		Base:   baseInspector{Path: s.Path, Fset: token.NewFileSet()},
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: fset, Partial: s.Config.Partial, Workers: s.Config.Workers,
+			Types: newTypeChecker(IdenticalTypes, fset),
+		},
+	}
+	return &inspector, nil
+}
+
+// referencesScoutSetup holds configuration for scanning the references to a symbol.
+type referencesScoutSetup struct {
+	Path   string
+	Source source
+	Target Symbol
+	Config ReferencesConfig
+}
+
+// initializeInspect validates the target symbol and returns an inspector for Reference.
+//
+//lint:ignore U1000 used via interface
+func (s referencesScoutSetup) initializeInspect() (inspector[Reference], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// A symbol must always be given to search for, with the type of a method or field.
+	if s.Target.Name == "" {
+		return nil, errors.New("a symbol name must be specified")
+	}
+	if (s.Target.Kind == MethodSymbol || s.Target.Kind == FieldSymbol) && s.Target.Receiver == "" {
+		return nil, fmt.Errorf("a receiver must be specified for a %s symbol", s.Target.Kind)
+	}
+
+	// Create and return the references inspector, which type-checks to resolve identifiers.
+	fset := token.NewFileSet()
+	inspector := referencesInspector{
+		Nodes:  []*Reference{},
+		Target: s.Target,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: fset, Partial: s.Config.Partial, Workers: s.Config.Workers,
+			Types: newTypeChecker(IdenticalTypes, fset),
+		},
+	}
+	return &inspector, nil
+}
+
+// constScoutSetup holds configuration for scanning constants.
+type constScoutSetup struct {
+	Path   string
+	Source source
+	Config ConstConfig
+}
+
+// initializeInspect resolves the files to scan and returns an inspector for ConstNode.
+//
+//lint:ignore U1000 used via interface
+func (s constScoutSetup) initializeInspect() (inspector[ConstNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Compile the name patterns, which must be well-formed.
+	patterns, patternErr := compilePatterns(s.Config.PatternMode, s.Config.NamePattern)
+	if patternErr != nil {
+		return nil, patternErr
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	// Create and return the constant inspector.
+	inspector := constInspector{
+		Nodes:    []*ConstNode{},
+		Config:   s.Config,
+		patterns: patterns,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: token.NewFileSet(), Partial: s.Config.Partial, Workers: s.Config.Workers,
+		},
+	}
+	return &inspector, nil
+}
+
+// varScoutSetup holds configuration for scanning variables.
+type varScoutSetup struct {
+	Path   string
+	Source source
+	Config VarConfig
+}
+
+// initializeInspect resolves the files to scan and returns an inspector for VarNode.
+//
+//lint:ignore U1000 used via interface
+func (s varScoutSetup) initializeInspect() (inspector[VarNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Compile the name patterns, which must be well-formed.
+	patterns, patternErr := compilePatterns(s.Config.PatternMode, s.Config.NamePattern)
+	if patternErr != nil {
+		return nil, patternErr
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	// Create and return the variable inspector.
+	inspector := varInspector{
+		Nodes:    []*VarNode{},
+		Config:   s.Config,
+		patterns: patterns,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: token.NewFileSet(), Partial: s.Config.Partial, Workers: s.Config.Workers,
+		},
+	}
+	return &inspector, nil
+}
+
+// typeScoutSetup holds configuration for scanning declared types.
+type typeScoutSetup struct {
+	Path   string
+	Source source
+	Config TypeConfig
+}
+
+// initializeInspect validates type-related configuration and returns an inspector for TypeNode.
+//
+//lint:ignore U1000 used via interface
+func (s typeScoutSetup) initializeInspect() (inspector[TypeNode], error) {
+	// Resolve the provided path or source into the Go files it refers to.
+	src := sourceFor(s.Path, s.Source)
+	files, resolveErr := src.files()
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	// Compile the name patterns, which must be well-formed.
+	patterns, patternErr := compilePatterns(s.Config.PatternMode, s.Config.NamePattern)
+	if patternErr != nil {
+		return nil, patternErr
+	}
+
+	// Ensure the sort order, if specified, is a known one.
+	sortErr := validateSortOrder(s.Config.Sort)
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	// Check that the kind, if specified, is one that a type can be reported as.
+	if s.Config.Kind != "" && !slices.Contains(TypeKinds, s.Config.Kind) {
+		return nil, fmt.Errorf("Kind must be one of: %v", TypeKinds)
+	}
+
+	// Create validation rules for type methods.
+	batchValidation := validation.BatchConfigValidation{
+		SliceValidators: []validation.SliceValidator{
+			validation.SlicePairToValidate[string]{
+				Slice: validation.Arg("Methods", s.Config.Methods),
+				Bool:  validation.Arg("NoMethods", s.Config.NoMethods),
+			},
+		},
+		Exact: s.Config.Exact,
+	}
+
+	// Run batch validation and return an error if it fails.
+	batchErr := batchValidation.Validate()
+	if batchErr != nil {
+		return nil, batchErr
+	}
+
+	// Create and return the type inspector.
+	inspector := typeInspector{
+		Nodes:    []*TypeNode{},
+		Config:   s.Config,
+		patterns: patterns,
+		Base: baseInspector{
+			Path: s.Path, Files: files, Source: src, Fset: token.NewFileSet(), Partial: s.Config.Partial, Workers: s.Config.Workers,
+		},
 	}
 	return &inspector, nil
 }
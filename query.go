@@ -0,0 +1,769 @@
+package codescout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// QueryMatch is a declaration matched by a query.
+type QueryMatch struct {
+	// Kind of the declaration: "func", "method", "struct", "interface", "type", "const" or "var".
+	Kind string
+	// Node contains metadata such as name, path, line number, etc.
+	Node BaseNode
+	// Value is the matched node: a *FuncNode, *MethodNode, *StructNode, *InterfaceNode,
+	// *TypeNode, *ConstNode or *VarNode according to Kind.
+	Value any
+}
+
+// Code returns the full source code of the matched declaration.
+func (m QueryMatch) Code() string {
+	if node, ok := m.Value.(interface{ Code() string }); ok {
+		return node.Code()
+	}
+	return ""
+}
+
+// MarshalJSON encodes the match as its kind and the encoded node.
+func (m QueryMatch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Kind string `json:"kind"`
+		Node any    `json:"node"`
+	}{Kind: m.Kind, Node: m.Value})
+}
+
+// queryTokenKind classifies the tokens of a query.
+type queryTokenKind int
+
+const (
+	queryEOF queryTokenKind = iota
+	queryWord
+	queryString
+	queryLParen
+	queryRParen
+	queryComma
+	queryOperator
+)
+
+// queryToken is a single token of a query and its byte offset.
+type queryToken struct {
+	Kind   queryTokenKind
+	Text   string
+	Offset int
+}
+
+// lexQuery splits a query into tokens. Words run until whitespace, a parenthesis, a comma,
+// a quote or an operator, so types such as "*Server" or "[]byte" need no quoting.
+func lexQuery(expr string) ([]queryToken, error) {
+	tokens := make([]queryToken, 0)
+	for offset := 0; offset < len(expr); {
+		char := rune(expr[offset])
+		switch {
+		case unicode.IsSpace(char):
+			offset++
+		case char == '(':
+			tokens = append(tokens, queryToken{Kind: queryLParen, Text: "(", Offset: offset})
+			offset++
+		case char == ')':
+			tokens = append(tokens, queryToken{Kind: queryRParen, Text: ")", Offset: offset})
+			offset++
+		case char == ',':
+			tokens = append(tokens, queryToken{Kind: queryComma, Text: ",", Offset: offset})
+			offset++
+		case strings.HasPrefix(expr[offset:], "=~") || strings.HasPrefix(expr[offset:], "!="):
+			tokens = append(tokens, queryToken{Kind: queryOperator, Text: expr[offset : offset+2], Offset: offset})
+			offset += 2
+		case char == '=':
+			tokens = append(tokens, queryToken{Kind: queryOperator, Text: "=", Offset: offset})
+			offset++
+		case char == '"':
+			// A backslash escapes the character after it, so a string can hold quotes,
+			// as in "json:\"-\"", and is unquoted as a Go string.
+			end := offset + 1
+			for end < len(expr) && expr[end] != '"' {
+				if expr[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expr) {
+				return nil, fmt.Errorf("unterminated string at offset %d in query", offset)
+			}
+			text, err := strconv.Unquote(expr[offset : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at offset %d in query: %w", offset, err)
+			}
+			tokens = append(tokens, queryToken{Kind: queryString, Text: text, Offset: offset})
+			offset = end + 1
+		default:
+			end := offset
+			for end < len(expr) && !strings.ContainsRune(" \t\r\n(),\"=!", rune(expr[end])) {
+				end++
+			}
+			if end == offset {
+				return nil, fmt.Errorf("unexpected %q at offset %d in query", expr[offset], offset)
+			}
+			tokens = append(tokens, queryToken{Kind: queryWord, Text: expr[offset:end], Offset: offset})
+			offset = end
+		}
+	}
+	return append(tokens, queryToken{Kind: queryEOF, Offset: len(expr)}), nil
+}
+
+// queryExpr is a node of a parsed query.
+type queryExpr interface{}
+
+// queryBinary joins two expressions with "and" or "or".
+type queryBinary struct {
+	Op    string
+	Left  queryExpr
+	Right queryExpr
+}
+
+// queryNot negates an expression.
+type queryNot struct {
+	Expr queryExpr
+}
+
+// queryKindExpr matches declarations of a kind satisfying an optional condition.
+type queryKindExpr struct {
+	Kind      string
+	Condition queryExpr
+}
+
+// queryPredicate is a single criterion within a kind, e.g. `returns has error`.
+type queryPredicate struct {
+	Field  string
+	Op     string
+	Value  string
+	Offset int
+}
+
+// queryParser is a recursive-descent parser over the tokens of a query:
+//
+//	expr      = and { "or" and }
+//	and       = unary { ("and" | ",") unary }
+//	unary     = "not" unary | "(" expr ")" | kind "(" [expr] ")" | predicate
+//	predicate = field [ ("=" | "!=" | "=~" | "like" | "has") value | value ]
+//
+// Kinds are only valid outside a kind's parentheses and predicates only within them, and a
+// comma is only valid within them.
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+// queryKinds are the declaration kinds a query can match, in the order they are scouted.
+var queryKinds = []string{"func", "method", "struct", "interface", "type", "const", "var"}
+
+// parseQuery parses a query expression into its expression tree.
+func parseQuery(expr string) (queryExpr, error) {
+	tokens, err := lexQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	parser := queryParser{tokens: tokens}
+	parsed, err := parser.parseOr(false)
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.Kind != queryEOF {
+		return nil, parser.unexpected(token)
+	}
+	return parsed, nil
+}
+
+// peek returns the current token without consuming it.
+func (p *queryParser) peek() queryToken { return p.tokens[p.pos] }
+
+// next consumes and returns the current token.
+func (p *queryParser) next() queryToken {
+	token := p.tokens[p.pos]
+	if token.Kind != queryEOF {
+		p.pos++
+	}
+	return token
+}
+
+// isKeyword returns true if the token is the given keyword.
+func isKeyword(token queryToken, keyword string) bool {
+	return token.Kind == queryWord && token.Text == keyword
+}
+
+// unexpected returns the error for a token that cannot appear where it was found.
+func (p *queryParser) unexpected(token queryToken) error {
+	if token.Kind == queryEOF {
+		return fmt.Errorf("unexpected end of query at offset %d", token.Offset)
+	}
+	return fmt.Errorf("unexpected %q at offset %d in query", token.Text, token.Offset)
+}
+
+// expect consumes a token of the given kind or returns an error.
+func (p *queryParser) expect(kind queryTokenKind) error {
+	if token := p.next(); token.Kind != kind {
+		return p.unexpected(token)
+	}
+	return nil
+}
+
+// parseOr parses expressions joined by "or".
+func (p *queryParser) parseOr(inKind bool) (queryExpr, error) {
+	left, err := p.parseAnd(inKind)
+	if err != nil {
+		return nil, err
+	}
+	for isKeyword(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd(inKind)
+		if err != nil {
+			return nil, err
+		}
+		left = queryBinary{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses expressions joined by "and", or by commas within a kind.
+func (p *queryParser) parseAnd(inKind bool) (queryExpr, error) {
+	left, err := p.parseUnary(inKind)
+	if err != nil {
+		return nil, err
+	}
+	for isKeyword(p.peek(), "and") || (inKind && p.peek().Kind == queryComma) {
+		p.next()
+		right, err := p.parseUnary(inKind)
+		if err != nil {
+			return nil, err
+		}
+		left = queryBinary{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseUnary parses a negation, a parenthesised expression, a kind or a predicate.
+func (p *queryParser) parseUnary(inKind bool) (queryExpr, error) {
+	token := p.peek()
+	switch {
+	case isKeyword(token, "not"):
+		p.next()
+		expr, err := p.parseUnary(inKind)
+		if err != nil {
+			return nil, err
+		}
+		return queryNot{Expr: expr}, nil
+	case token.Kind == queryLParen:
+		p.next()
+		expr, err := p.parseOr(inKind)
+		if err != nil {
+			return nil, err
+		}
+		return expr, p.expect(queryRParen)
+	case token.Kind != queryWord:
+		return nil, p.unexpected(token)
+	case inKind:
+		return p.parsePredicate()
+	}
+	return p.parseKind()
+}
+
+// parseKind parses a kind and its parenthesised condition, which may be empty.
+func (p *queryParser) parseKind() (queryExpr, error) {
+	token := p.next()
+	if !slices.Contains(queryKinds, token.Text) {
+		return nil, fmt.Errorf(
+			"unknown kind %q at offset %d in query, must be one of: %s", token.Text, token.Offset, strings.Join(queryKinds, ", "),
+		)
+	}
+	if err := p.expect(queryLParen); err != nil {
+		return nil, err
+	}
+	kindExpr := queryKindExpr{Kind: token.Text}
+	if p.peek().Kind == queryRParen {
+		p.next()
+		return kindExpr, nil
+	}
+	condition, err := p.parseOr(true)
+	if err != nil {
+		return nil, err
+	}
+	kindExpr.Condition = condition
+	return kindExpr, p.expect(queryRParen)
+}
+
+// parsePredicate parses a field, an optional operator and a value. A value directly after the
+// field, as in `calls Close`, is read as `has`.
+func (p *queryParser) parsePredicate() (queryExpr, error) {
+	field := p.next()
+	predicate := queryPredicate{Field: field.Text, Offset: field.Offset}
+
+	token := p.peek()
+	isWordOperator := isKeyword(token, "has") || isKeyword(token, "like")
+	switch {
+	case token.Kind == queryOperator || isWordOperator:
+		p.next()
+		predicate.Op = token.Text
+	case token.Kind == queryString || (token.Kind == queryWord && !isKeyword(token, "and") && !isKeyword(token, "or")):
+		predicate.Op = "has"
+	default:
+		return predicate, nil
+	}
+
+	value := p.next()
+	if value.Kind != queryWord && value.Kind != queryString {
+		return nil, p.unexpected(value)
+	}
+	predicate.Value = value.Text
+	return predicate, nil
+}
+
+// queryMatcher reports whether a declaration satisfies a compiled query.
+type queryMatcher func(match *QueryMatch) bool
+
+// compileQuery turns a parsed query into a matcher and records the kinds it refers to.
+func compileQuery(expr queryExpr, kinds map[string]bool) (queryMatcher, error) {
+	switch node := expr.(type) {
+	case queryBinary:
+		left, err := compileQuery(node.Left, kinds)
+		if err != nil {
+			return nil, err
+		}
+		right, err := compileQuery(node.Right, kinds)
+		if err != nil {
+			return nil, err
+		}
+		if node.Op == "or" {
+			return func(match *QueryMatch) bool { return left(match) || right(match) }, nil
+		}
+		return func(match *QueryMatch) bool { return left(match) && right(match) }, nil
+	case queryNot:
+		inner, err := compileQuery(node.Expr, kinds)
+		if err != nil {
+			return nil, err
+		}
+		// A negated kind matches declarations of every other kind too, so all are scouted.
+		for _, kind := range queryKinds {
+			kinds[kind] = true
+		}
+		return func(match *QueryMatch) bool { return !inner(match) }, nil
+	case queryKindExpr:
+		kinds[node.Kind] = true
+		condition := func(*QueryMatch) bool { return true }
+		if node.Condition != nil {
+			compiled, err := compileCondition(node.Kind, node.Condition)
+			if err != nil {
+				return nil, err
+			}
+			condition = compiled
+		}
+		return func(match *QueryMatch) bool { return match.Kind == node.Kind && condition(match) }, nil
+	}
+	return nil, fmt.Errorf("a predicate must be inside a kind, e.g. func(...)")
+}
+
+// compileCondition turns the condition of a kind into a matcher.
+func compileCondition(kind string, expr queryExpr) (queryMatcher, error) {
+	switch node := expr.(type) {
+	case queryBinary:
+		left, err := compileCondition(kind, node.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := compileCondition(kind, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		if node.Op == "or" {
+			return func(match *QueryMatch) bool { return left(match) || right(match) }, nil
+		}
+		return func(match *QueryMatch) bool { return left(match) && right(match) }, nil
+	case queryNot:
+		inner, err := compileCondition(kind, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(match *QueryMatch) bool { return !inner(match) }, nil
+	case queryPredicate:
+		return compilePredicate(kind, node)
+	}
+	return nil, fmt.Errorf("a kind cannot be nested inside %s(...)", kind)
+}
+
+// compilePredicate turns a predicate into a matcher built on the inspector of its kind, so that
+// each criterion is matched exactly as the corresponding config field would be.
+func compilePredicate(kind string, predicate queryPredicate) (queryMatcher, error) {
+	negate := predicate.Op == "!="
+	if negate {
+		predicate.Op = "="
+	}
+
+	var matcher queryMatcher
+	var err error
+	if predicate.Field == "exported" {
+		err = predicate.expectFlag()
+		matcher = func(match *QueryMatch) bool { return match.Node.Exported }
+	} else {
+		switch kind {
+		case "func":
+			matcher, err = funcQueryPredicate(predicate)
+		case "method":
+			matcher, err = methodQueryPredicate(predicate)
+		case "struct":
+			matcher, err = structQueryPredicate(predicate)
+		case "interface":
+			matcher, err = interfaceQueryPredicate(predicate)
+		case "type":
+			matcher, err = typeQueryPredicate(predicate)
+		case "const":
+			matcher, err = constQueryPredicate(predicate)
+		case "var":
+			matcher, err = varQueryPredicate(predicate)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if negate {
+		return func(match *QueryMatch) bool { return !matcher(match) }, nil
+	}
+	return matcher, nil
+}
+
+// unknownField returns the error for a field that does not apply to the kind.
+func (p queryPredicate) unknownField(kind string) error {
+	return fmt.Errorf("unknown %s field %q at offset %d in query", kind, p.Field, p.Offset)
+}
+
+// expectOps returns an error unless the predicate uses one of the operators.
+func (p queryPredicate) expectOps(ops ...string) error {
+	if !slices.Contains(ops, p.Op) {
+		return fmt.Errorf("%s at offset %d in query must be used with one of: %s", p.Field, p.Offset, strings.Join(ops, ", "))
+	}
+	return nil
+}
+
+// expectFlag returns an error unless the predicate is a bare field, e.g. `generic`.
+func (p queryPredicate) expectFlag() error {
+	if p.Op != "" {
+		return fmt.Errorf("%s at offset %d in query does not take a value", p.Field, p.Offset)
+	}
+	return nil
+}
+
+// name converts a name predicate into the exact name or pattern it matches, and the pattern
+// compiled.
+func (p queryPredicate) name() (string, string, PatternMode, namePatterns, error) {
+	if err := p.expectOps("=", "=~", "like"); err != nil {
+		return "", "", "", nil, err
+	}
+	switch p.Op {
+	case "=~":
+		patterns, err := compilePatterns(RegexPattern, p.Value)
+		return "", p.Value, RegexPattern, patterns, err
+	case "like":
+		patterns, err := compilePatterns(GlobPattern, p.Value)
+		return "", p.Value, GlobPattern, patterns, err
+	}
+	return p.Value, "", "", nil, nil
+}
+
+// namedType converts a `has` predicate value into a NamedType, written as "name:type",
+// ":type" or just "type".
+func (p queryPredicate) namedType() (NamedType, error) {
+	if err := p.expectOps("has"); err != nil {
+		return NamedType{}, err
+	}
+	name, typ, hasName := strings.Cut(p.Value, ":")
+	if !hasName {
+		return NamedType{Type: p.Value}, nil
+	}
+	return NamedType{Name: strings.TrimSpace(name), Type: strings.TrimSpace(typ)}, nil
+}
+
+// values converts a `has` predicate value into a single-entry slice.
+func (p queryPredicate) values() ([]string, error) {
+	if err := p.expectOps("has"); err != nil {
+		return nil, err
+	}
+	return []string{p.Value}, nil
+}
+
+// exact converts an `=` predicate into its value.
+func (p queryPredicate) exact() (string, error) {
+	return p.Value, p.expectOps("=")
+}
+
+// flag converts a bare predicate into a pointer to true.
+func (p queryPredicate) flag() (*bool, error) {
+	isSet := true
+	return &isSet, p.expectFlag()
+}
+
+// funcQueryPredicate compiles a predicate on functions.
+func funcQueryPredicate(p queryPredicate) (queryMatcher, error) {
+	var config FuncConfig
+	var patterns namePatterns
+	var err error
+	switch p.Field {
+	case "name":
+		config.Name, config.NamePattern, config.PatternMode, patterns, err = p.name()
+	case "params":
+		var param NamedType
+		param, err = p.namedType()
+		config.ParamTypes = []NamedType{param}
+	case "returns":
+		config.ReturnTypes, err = p.values()
+	case "typeparams":
+		var param NamedType
+		param, err = p.namedType()
+		config.TypeParams = []NamedType{param}
+	case "generic":
+		config.IsGeneric, err = p.flag()
+	default:
+		return nil, p.unknownField("func")
+	}
+	inspector := funcInspector{Config: config, patterns: patterns}
+	return func(match *QueryMatch) bool { return inspector.isNodeMatch(match.Value.(*FuncNode)) }, err
+}
+
+// methodQueryPredicate compiles a predicate on methods. A receiver written as "*Server" also
+// requires a pointer receiver.
+func methodQueryPredicate(p queryPredicate) (queryMatcher, error) {
+	var config MethodConfig
+	var patterns namePatterns
+	var err error
+	switch p.Field {
+	case "name":
+		config.Name, config.NamePattern, config.PatternMode, patterns, err = p.name()
+	case "receiver":
+		if p.Op == "=" && strings.HasPrefix(p.Value, "*") {
+			p.Value = strings.TrimPrefix(p.Value, "*")
+			config.IsPointerRec, _ = p.flag()
+		}
+		config.Receiver, config.ReceiverPattern, config.PatternMode, patterns, err = p.name()
+	case "params":
+		var param NamedType
+		param, err = p.namedType()
+		config.ParamTypes = []NamedType{param}
+	case "returns":
+		config.ReturnTypes, err = p.values()
+	case "calls":
+		config.Methods, err = p.values()
+	case "accesses":
+		config.Fields, err = p.values()
+	case "pointer":
+		config.IsPointerRec, err = p.flag()
+	case "typeparams":
+		var param NamedType
+		param, err = p.namedType()
+		config.TypeParams = []NamedType{param}
+	case "generic":
+		config.IsGeneric, err = p.flag()
+	default:
+		return nil, p.unknownField("method")
+	}
+	inspector := methodInspector{Config: config, patterns: patterns}
+	return func(match *QueryMatch) bool {
+		node := match.Value.(*MethodNode)
+		return inspector.isNodeMatch(node) && inspector.isAttrsMatch(node)
+	}, err
+}
+
+// structQueryPredicate compiles a predicate on structs.
+func structQueryPredicate(p queryPredicate) (queryMatcher, error) {
+	var config StructConfig
+	var patterns namePatterns
+	var err error
+	switch p.Field {
+	case "name":
+		config.Name, config.NamePattern, config.PatternMode, patterns, err = p.name()
+	case "fields":
+		var field NamedType
+		field, err = p.namedType()
+		config.FieldTypes = []NamedType{field}
+	case "embeds":
+		config.Embeds, err = p.values()
+	case "tag":
+		err = p.expectOps("=", "has")
+		key, value, _ := strings.Cut(p.Value, ":")
+		if unquoted, unquoteErr := strconv.Unquote(value); unquoteErr == nil {
+			value = unquoted
+		}
+		config.Tags = []TagFilter{{Key: key, Value: value}}
+	case "typeparams":
+		var param NamedType
+		param, err = p.namedType()
+		config.TypeParams = []NamedType{param}
+	case "generic":
+		config.IsGeneric, err = p.flag()
+	case "methods":
+		var methods []string
+		methods, err = p.values()
+		return func(match *QueryMatch) bool {
+			node := match.Value.(*StructNode)
+			for _, method := range node.Methods {
+				if method.Name() == methods[0] {
+					return true
+				}
+			}
+			return false
+		}, err
+	default:
+		return nil, p.unknownField("struct")
+	}
+	inspector := structInspector{Config: config, patterns: patterns}
+	return func(match *QueryMatch) bool { return inspector.isNodeMatch(match.Value.(*StructNode)) }, err
+}
+
+// interfaceQueryPredicate compiles a predicate on interfaces.
+func interfaceQueryPredicate(p queryPredicate) (queryMatcher, error) {
+	var config InterfaceConfig
+	var patterns namePatterns
+	var err error
+	switch p.Field {
+	case "name":
+		config.Name, config.NamePattern, config.PatternMode, patterns, err = p.name()
+	case "methods":
+		var methods []string
+		methods, err = p.values()
+		if err == nil {
+			config.Methods = []MethodSignature{{Name: methods[0]}}
+		}
+	case "embeds":
+		config.Embeds, err = p.values()
+	case "typeset":
+		config.TypeSet, err = p.values()
+	default:
+		return nil, p.unknownField("interface")
+	}
+	inspector := interfaceInspector{Config: config, patterns: patterns}
+	return func(match *QueryMatch) bool { return inspector.isNodeMatch(match.Value.(*InterfaceNode)) }, err
+}
+
+// typeQueryPredicate compiles a predicate on declared types.
+func typeQueryPredicate(p queryPredicate) (queryMatcher, error) {
+	var config TypeConfig
+	var patterns namePatterns
+	var err error
+	switch p.Field {
+	case "name":
+		config.Name, config.NamePattern, config.PatternMode, patterns, err = p.name()
+	case "kind":
+		var kind string
+		kind, err = p.exact()
+		config.Kind = TypeKind(kind)
+	case "underlying":
+		config.Underlying, err = p.exact()
+	case "methods":
+		config.Methods, err = p.values()
+	default:
+		return nil, p.unknownField("type")
+	}
+	inspector := typeInspector{Config: config, patterns: patterns}
+	return func(match *QueryMatch) bool { return inspector.isNodeMatch(match.Value.(*TypeNode)) }, err
+}
+
+// constQueryPredicate compiles a predicate on constants.
+func constQueryPredicate(p queryPredicate) (queryMatcher, error) {
+	var config ConstConfig
+	var patterns namePatterns
+	var err error
+	switch p.Field {
+	case "name":
+		config.Name, config.NamePattern, config.PatternMode, patterns, err = p.name()
+	case "type":
+		config.Type, err = p.exact()
+	case "enum":
+		config.IsEnum, err = p.flag()
+	default:
+		return nil, p.unknownField("const")
+	}
+	inspector := constInspector{Config: config, patterns: patterns}
+	return func(match *QueryMatch) bool { return inspector.isNodeMatch(match.Value.(*ConstNode)) }, err
+}
+
+// varQueryPredicate compiles a predicate on variables.
+func varQueryPredicate(p queryPredicate) (queryMatcher, error) {
+	var config VarConfig
+	var patterns namePatterns
+	var err error
+	switch p.Field {
+	case "name":
+		config.Name, config.NamePattern, config.PatternMode, patterns, err = p.name()
+	case "type":
+		config.Type, err = p.exact()
+	default:
+		return nil, p.unknownField("var")
+	}
+	inspector := varInspector{Config: config, patterns: patterns}
+	return func(match *QueryMatch) bool { return inspector.isNodeMatch(match.Value.(*VarNode)) }, err
+}
+
+// queryCandidates scouts every declaration of the kinds a query refers to.
+func queryCandidates(ctx context.Context, path string, kinds map[string]bool) ([]*QueryMatch, error) {
+	candidates := make([]*QueryMatch, 0)
+	for _, kind := range queryKinds {
+		if !kinds[kind] {
+			continue
+		}
+		var err error
+		switch kind {
+		case "func":
+			candidates, err = appendCandidates(ctx, candidates, kind, path, ScoutFunctionsWithContext, FuncConfig{},
+				func(node *FuncNode) BaseNode { return node.Node })
+		case "method":
+			candidates, err = appendCandidates(ctx, candidates, kind, path, ScoutMethodsWithContext, MethodConfig{},
+				func(node *MethodNode) BaseNode { return node.Node })
+		case "struct":
+			candidates, err = appendCandidates(ctx, candidates, kind, path, ScoutStructsWithContext, StructConfig{},
+				func(node *StructNode) BaseNode { return node.Node })
+		case "interface":
+			candidates, err = appendCandidates(ctx, candidates, kind, path, ScoutInterfacesWithContext, InterfaceConfig{},
+				func(node *InterfaceNode) BaseNode { return node.Node })
+		case "type":
+			candidates, err = appendCandidates(ctx, candidates, kind, path, ScoutTypesWithContext, TypeConfig{},
+				func(node *TypeNode) BaseNode { return node.Node })
+		case "const":
+			candidates, err = appendCandidates(ctx, candidates, kind, path, ScoutConstsWithContext, ConstConfig{},
+				func(node *ConstNode) BaseNode { return node.Node })
+		case "var":
+			candidates, err = appendCandidates(ctx, candidates, kind, path, ScoutVarsWithContext, VarConfig{},
+				func(node *VarNode) BaseNode { return node.Node })
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		left, right := candidates[i].Node, candidates[j].Node
+		if left.Path != right.Path {
+			return left.Path < right.Path
+		}
+		if left.Line != right.Line {
+			return left.Line < right.Line
+		}
+		return left.Characters < right.Characters
+	})
+	return candidates, nil
+}
+
+// appendCandidates scouts every declaration of one kind and appends it as a candidate match.
+func appendCandidates[T any, C any](
+	ctx context.Context, candidates []*QueryMatch, kind string, path string,
+	scout func(context.Context, string, C) ([]*T, error), config C, base func(*T) BaseNode,
+) ([]*QueryMatch, error) {
+	nodes, err := scout(ctx, path, config)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		candidates = append(candidates, &QueryMatch{Kind: kind, Node: base(node), Value: node})
+	}
+	return candidates, nil
+}
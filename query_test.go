@@ -0,0 +1,94 @@
+package codescout
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// queryNames returns the kind and name of each match.
+func queryNames(matches []*QueryMatch) []string {
+	names := make([]string, 0, len(matches))
+	for _, match := range matches {
+		names = append(names, match.Kind+" "+match.Node.Name)
+	}
+	return names
+}
+
+func TestParseQuery(t *testing.T) {
+	parsed, err := parseQuery(`func(name=~"^New", returns has error) or method(receiver=*Server and not calls Close)`)
+	assert.NoError(t, err)
+	assert.Equal(t, queryBinary{
+		Op: "or",
+		Left: queryKindExpr{Kind: "func", Condition: queryBinary{
+			Op:    "and",
+			Left:  queryPredicate{Field: "name", Op: "=~", Value: "^New", Offset: 5},
+			Right: queryPredicate{Field: "returns", Op: "has", Value: "error", Offset: 19},
+		}},
+		Right: queryKindExpr{Kind: "method", Condition: queryBinary{
+			Op:    "and",
+			Left:  queryPredicate{Field: "receiver", Op: "=", Value: "*Server", Offset: 48},
+			Right: queryNot{Expr: queryPredicate{Field: "calls", Op: "has", Value: "Close", Offset: 73}},
+		}},
+	}, parsed)
+
+	parsed, err = parseQuery(`struct(tag = "json:\"-\"")`)
+	assert.NoError(t, err)
+	assert.Equal(t, queryKindExpr{Kind: "struct", Condition: queryPredicate{Field: "tag", Op: "=", Value: `json:"-"`, Offset: 7}}, parsed)
+
+	parsed, err = parseQuery(`struct()`)
+	assert.NoError(t, err)
+	assert.Equal(t, queryKindExpr{Kind: "struct"}, parsed)
+
+	for expr, message := range map[string]string{
+		`func(name = "x"`:   "unexpected end of query at offset 15",
+		`func(name = "x"))`: `unexpected ")" at offset 16 in query`,
+		`fn()`:              `unknown kind "fn" at offset 0 in query, must be one of: func, method, struct, interface, type, const, var`,
+		`func(name = "x)`:   "unterminated string at offset 12 in query",
+		`func(, generic)`:   `unexpected "," at offset 5 in query`,
+	} {
+		_, err := parseQuery(expr)
+		assert.EqualError(t, err, message, expr)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	typedPath := filepath.Join("testdata", "scout_typed")
+	singlePath := filepath.Join("testdata", "scout_single.go")
+
+	matches, err := Query(typedPath, `func(name=~"^De", returns has error) or method(receiver=*Buffer and not calls Close)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"func Decode", "method Write"}, queryNames(matches))
+	assert.IsType(t, &FuncNode{}, matches[0].Value)
+	assert.Contains(t, matches[0].Code(), "func Decode(")
+
+	matches, err = Query(typedPath, `func(params has :...int or name like "En*")`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"func Encode", "func Sum"}, queryNames(matches))
+
+	matches, err = Query(singlePath, `struct(fields has Age:int) or func(not exported)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"struct Person", "func main"}, queryNames(matches))
+
+	matches, err = Query(singlePath, `method(accesses Year, pointer) or var(name != cars)`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"method DisplayDetails", "var DefaultGreeting"}, queryNames(matches))
+
+	matches, err = Query(singlePath, `not struct(name = Person) and not func() and not method()`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"type Person", "type Car", "struct Car", "var DefaultGreeting", "var cars"}, queryNames(matches))
+
+	matches, err = Query(filepath.Join("testdata", "scout_tags.go"), `struct(tag="json:\"-\"") or struct(tag has "db:\"session_id\"")`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"struct User"}, queryNames(matches))
+
+	_, err = Query(singlePath, `func(calls Close)`)
+	assert.EqualError(t, err, `unknown func field "calls" at offset 5 in query`)
+
+	_, err = Query(singlePath, `method(pointer = true)`)
+	assert.EqualError(t, err, "pointer at offset 7 in query does not take a value")
+
+	_, err = Query(singlePath, `func(name =~ "[")`)
+	assert.Error(t, err)
+}
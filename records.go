@@ -0,0 +1,163 @@
+package codescout
+
+import "encoding/json"
+
+// RecordSchemaVersion is the version of the serialised form produced by ToRecord and MarshalJSON.
+// It is incremented whenever a field is removed, renamed or changes meaning; adding a field
+// does not change the version.
+const RecordSchemaVersion = 1
+
+// BaseRecord is the serialisable form of a BaseNode.
+type BaseRecord struct {
+	// Name of the code element.
+	Name string `json:"name"`
+	// Path to the file where the element is defined.
+	Path string `json:"path"`
+	// Line number where the element starts.
+	Line int `json:"line"`
+	// Column where the element starts.
+	Column int `json:"column"`
+	// Whether the element is exported.
+	Exported bool `json:"exported"`
+	// Leading comment associated with the element.
+	Comment string `json:"comment"`
+	// Range of the whole declaration, without its doc comment.
+	Range Range `json:"range"`
+	// Range of the doc comment, zero when there is none.
+	DocRange Range `json:"doc_range"`
+	// Range of the signature.
+	SignatureRange Range `json:"signature_range"`
+	// Range of the body, zero when there is none.
+	BodyRange Range `json:"body_range"`
+}
+
+// FuncRecord is the serialisable form of a FuncNode, schema version RecordSchemaVersion.
+type FuncRecord struct {
+	// Schema is the RecordSchemaVersion the record was produced with.
+	Schema int `json:"schema"`
+	BaseRecord
+	// Signature of the function, e.g. "func Greet(name string) string".
+	Signature string `json:"signature"`
+	// Full source code of the function, including its doc comment.
+	Code string `json:"code"`
+	// Parameter names and types in declaration order.
+	Parameters []NamedType `json:"parameters"`
+	// Return types in declaration order.
+	ReturnTypes []string `json:"return_types"`
+	// Type parameter names and constraints in declaration order.
+	TypeParams []NamedType `json:"type_params"`
+}
+
+// MethodRecord is the serialisable form of a MethodNode, schema version RecordSchemaVersion.
+type MethodRecord struct {
+	FuncRecord
+	// Type of the receiver, without the pointer.
+	Receiver string `json:"receiver"`
+	// Name of the receiver variable.
+	ReceiverName string `json:"receiver_name"`
+	// Whether the method has a pointer receiver.
+	PointerReceiver bool `json:"pointer_receiver"`
+	// Type parameter names of a generic receiver.
+	ReceiverTypeParams []string `json:"receiver_type_params"`
+	// Receiver fields accessed by the method.
+	FieldsAccessed []string `json:"fields_accessed"`
+	// Receiver methods called by the method.
+	MethodsCalled []string `json:"methods_called"`
+}
+
+// StructRecord is the serialisable form of a StructNode, schema version RecordSchemaVersion.
+type StructRecord struct {
+	// Schema is the RecordSchemaVersion the record was produced with.
+	Schema int `json:"schema"`
+	BaseRecord
+	// Signature of the struct, its name and any type parameters with their constraints.
+	Signature string `json:"signature"`
+	// Type parameter names and constraints in declaration order.
+	TypeParams []NamedType `json:"type_params"`
+	// Full source code of the struct declaration.
+	Code string `json:"code"`
+	// Field names, types and tags in declaration order, including embedded fields.
+	Fields []NamedType `json:"fields"`
+	// Methods declared on the struct.
+	Methods []MethodRecord `json:"methods"`
+	// Fields promoted from embedded structs declared in the scouted code.
+	PromotedFields []NamedType `json:"promoted_fields"`
+	// Names of methods promoted from embedded structs declared in the scouted code.
+	PromotedMethods []string `json:"promoted_methods"`
+}
+
+// baseRecord converts the BaseNode into its serialisable form.
+func (b BaseNode) baseRecord() BaseRecord {
+	return BaseRecord{
+		Name:           b.Name,
+		Path:           b.Path,
+		Line:           b.Line,
+		Column:         b.Characters,
+		Exported:       b.Exported,
+		Comment:        b.Comment,
+		Range:          b.Range,
+		DocRange:       b.DocRange,
+		SignatureRange: b.SignatureRange,
+		BodyRange:      b.BodyRange,
+	}
+}
+
+// funcRecord converts the callable into its serialisable form.
+func (c CallableOps) funcRecord(node BaseNode) FuncRecord {
+	return FuncRecord{
+		Schema:      RecordSchemaVersion,
+		BaseRecord:  node.baseRecord(),
+		Signature:   c.Signature(),
+		Code:        c.Code(),
+		Parameters:  c.Parameters(),
+		ReturnTypes: c.ReturnTypes(),
+		TypeParams:  c.TypeParams(),
+	}
+}
+
+// ToRecord returns the serialisable form of the function.
+func (f FuncNode) ToRecord() FuncRecord { return f.CallableOps.funcRecord(f.Node) }
+
+// MarshalJSON encodes the function as its FuncRecord.
+func (f FuncNode) MarshalJSON() ([]byte, error) { return json.Marshal(f.ToRecord()) }
+
+// ToRecord returns the serialisable form of the method, whose type parameters are those of
+// its receiver.
+func (m MethodNode) ToRecord() MethodRecord {
+	record := MethodRecord{
+		FuncRecord:         m.CallableOps.funcRecord(m.Node),
+		Receiver:           m.ReceiverType(),
+		ReceiverName:       m.ReceiverName(),
+		PointerReceiver:    m.HasPointerReceiver(),
+		ReceiverTypeParams: m.ReceiverTypeParams(),
+		FieldsAccessed:     m.FieldsAccessed(),
+		MethodsCalled:      m.MethodsCalled(),
+	}
+	record.TypeParams = m.TypeParams()
+	return record
+}
+
+// MarshalJSON encodes the method as its MethodRecord.
+func (m MethodNode) MarshalJSON() ([]byte, error) { return json.Marshal(m.ToRecord()) }
+
+// ToRecord returns the serialisable form of the struct, including its methods.
+func (s StructNode) ToRecord() StructRecord {
+	methods := make([]MethodRecord, 0, len(s.Methods))
+	for _, method := range s.Methods {
+		methods = append(methods, method.ToRecord())
+	}
+	return StructRecord{
+		Schema:          RecordSchemaVersion,
+		BaseRecord:      s.Node.baseRecord(),
+		Signature:       s.Signature(),
+		TypeParams:      s.TypeParams(),
+		Code:            s.Code(),
+		Fields:          s.Fields(),
+		Methods:         methods,
+		PromotedFields:  s.PromotedFields(),
+		PromotedMethods: s.PromotedMethods(),
+	}
+}
+
+// MarshalJSON encodes the struct as its StructRecord.
+func (s StructNode) MarshalJSON() ([]byte, error) { return json.Marshal(s.ToRecord()) }
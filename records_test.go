@@ -0,0 +1,59 @@
+package codescout
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncNodeMarshalJSON(t *testing.T) {
+	path := filepath.Join("testdata", "scout_single.go")
+	funcNode, err := ScoutFunction(path, FuncConfig{Name: "Greet"})
+	assert.NoError(t, err)
+
+	encoded, err := json.Marshal(funcNode)
+	assert.NoError(t, err)
+
+	var record FuncRecord
+	assert.NoError(t, json.Unmarshal(encoded, &record))
+	assert.Equal(t, funcNode.ToRecord(), record)
+	assert.Equal(t, RecordSchemaVersion, record.Schema)
+	assert.Equal(t, "Greet", record.Name)
+	assert.Equal(t, 20, record.Line)
+	assert.Equal(t, []NamedType{{Name: "p", Type: "Person"}}, record.Parameters)
+	assert.Equal(t, []string{"string"}, record.ReturnTypes)
+}
+
+func TestMethodNodeMarshalJSON(t *testing.T) {
+	path := filepath.Join("testdata", "scout_single.go")
+	methodNode, err := ScoutMethod(path, MethodConfig{Name: "Birthday"})
+	assert.NoError(t, err)
+
+	encoded, err := json.Marshal(methodNode)
+	assert.NoError(t, err)
+	assert.Contains(t, string(encoded), `"receiver":"Person","receiver_name":"p","pointer_receiver":true`)
+
+	var record MethodRecord
+	assert.NoError(t, json.Unmarshal(encoded, &record))
+	assert.Equal(t, methodNode.ToRecord(), record)
+	assert.Equal(t, []string{"Age"}, record.FieldsAccessed)
+	assert.Equal(t, []string{}, record.MethodsCalled)
+}
+
+func TestStructNodeMarshalJSON(t *testing.T) {
+	path := filepath.Join("testdata", "scout_single.go")
+	structNode, err := ScoutStruct(path, StructConfig{Name: "Person"})
+	assert.NoError(t, err)
+
+	encoded, err := json.Marshal(structNode)
+	assert.NoError(t, err)
+
+	var record StructRecord
+	assert.NoError(t, json.Unmarshal(encoded, &record))
+	assert.Equal(t, structNode.ToRecord(), record)
+	assert.Equal(t, []NamedType{{Name: "Name", Type: "string"}, {Name: "Age", Type: "int"}}, record.Fields)
+	assert.Len(t, record.Methods, 1)
+	assert.Equal(t, "Birthday", record.Methods[0].Name)
+}
@@ -0,0 +1,195 @@
+package codescout
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+)
+
+// SymbolKind is the kind of declaration a Symbol refers to.
+type SymbolKind string
+
+const (
+	// FuncSymbol is a package-level function.
+	FuncSymbol SymbolKind = "func"
+	// MethodSymbol is a method declared on a named type.
+	MethodSymbol SymbolKind = "method"
+	// StructSymbol is a struct type.
+	StructSymbol SymbolKind = "struct"
+	// FieldSymbol is a field of a struct type.
+	FieldSymbol SymbolKind = "field"
+	// ConstSymbol is a package-level constant.
+	ConstSymbol SymbolKind = "const"
+)
+
+// Symbol identifies a declaration whose references can be searched for.
+type Symbol struct {
+	// Kind of the declaration.
+	Kind SymbolKind
+	// Name of the declaration, the method or field name for methods and fields.
+	Name string
+	// Type the method or field belongs to, empty for other kinds.
+	Receiver string
+	// Path to the file where the declaration is.
+	Path string
+}
+
+// Symbol returns the symbol itself, so a Symbol can be passed wherever a Referable is expected.
+func (s Symbol) Symbol() Symbol { return s }
+
+// Referable is implemented by the nodes whose references can be searched for.
+type Referable interface {
+	Symbol() Symbol
+}
+
+// Symbol returns the symbol of the function.
+func (f FuncNode) Symbol() Symbol {
+	return Symbol{Kind: FuncSymbol, Name: f.Node.Name, Path: f.Node.Path}
+}
+
+// Symbol returns the symbol of the method.
+func (m MethodNode) Symbol() Symbol {
+	return Symbol{Kind: MethodSymbol, Name: m.Node.Name, Receiver: m.ReceiverType(), Path: m.Node.Path}
+}
+
+// Symbol returns the symbol of the struct.
+func (s StructNode) Symbol() Symbol {
+	return Symbol{Kind: StructSymbol, Name: s.Node.Name, Path: s.Node.Path}
+}
+
+// FieldSymbol returns the symbol of a field of the struct, using the type name for an
+// embedded field.
+func (s StructNode) FieldSymbol(name string) Symbol {
+	return Symbol{Kind: FieldSymbol, Name: name, Receiver: s.Node.Name, Path: s.Node.Path}
+}
+
+// Symbol returns the symbol of the constant, or of the first non-blank member of an enum group.
+func (c ConstNode) Symbol() Symbol {
+	return c.MemberSymbol(firstNamedMember(c.Members))
+}
+
+// MemberSymbol returns the symbol of a named member of the constant declaration.
+func (c ConstNode) MemberSymbol(name string) Symbol {
+	return Symbol{Kind: ConstSymbol, Name: name, Path: c.Node.Path}
+}
+
+// Reference is a single use of a symbol.
+type Reference struct {
+	// Name of the referenced symbol.
+	Name string `json:"name"`
+	// Path to the file containing the use.
+	Path string `json:"path"`
+	// Line number of the use.
+	Line int `json:"line"`
+	// Column of the use.
+	Column int `json:"column"`
+	// Qualified name of the declaration containing the use, e.g. "storage.Open" or
+	// "storage.Store.Get", empty for uses outside any declaration.
+	Enclosing string `json:"enclosing"`
+	// Whether the use was matched by name because it could not be resolved with go/types.
+	Syntactic bool `json:"syntactic"`
+}
+
+// symbolObject looks up the checked object declared for the symbol in the package.
+func symbolObject(pkg *types.Package, target Symbol) types.Object {
+	switch obj := pkg.Scope().Lookup(target.Name); target.Kind {
+	case FuncSymbol:
+		if fn, ok := obj.(*types.Func); ok {
+			return fn
+		}
+		return nil
+	case StructSymbol:
+		if typeName, ok := obj.(*types.TypeName); ok {
+			return typeName
+		}
+		return nil
+	case ConstSymbol:
+		if constant, ok := obj.(*types.Const); ok {
+			return constant
+		}
+		return nil
+	}
+
+	typeName, ok := pkg.Scope().Lookup(target.Receiver).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	if target.Kind == MethodSymbol {
+		if named, ok := typeName.Type().(*types.Named); ok {
+			for idx := 0; idx < named.NumMethods(); idx++ {
+				if named.Method(idx).Name() == target.Name {
+					return named.Method(idx)
+				}
+			}
+		}
+		return nil
+	}
+	if structType, ok := typeName.Type().Underlying().(*types.Struct); ok {
+		for idx := 0; idx < structType.NumFields(); idx++ {
+			if structType.Field(idx).Name() == target.Name {
+				return structType.Field(idx)
+			}
+		}
+	}
+	return nil
+}
+
+// declarationKey returns the absolute file and offset of a position, which identifies a
+// declaration across packages type-checked separately with the same file set.
+func declarationKey(position token.Position) token.Position {
+	if absolute, err := filepath.Abs(position.Filename); err == nil {
+		position.Filename = absolute
+	}
+	return token.Position{Filename: position.Filename, Offset: position.Offset}
+}
+
+// declarationIdents returns the identifiers declared by the file, which are never uses.
+func declarationIdents(file *ast.File) map[*ast.Ident]bool {
+	idents := make(map[*ast.Ident]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncDecl:
+			idents[node.Name] = true
+		case *ast.TypeSpec:
+			idents[node.Name] = true
+		case *ast.ValueSpec:
+			for _, name := range node.Names {
+				idents[name] = true
+			}
+		case *ast.Field:
+			for _, name := range node.Names {
+				idents[name] = true
+			}
+		}
+		return true
+	})
+	return idents
+}
+
+// enclosingDecl returns the name of the top-level declaration containing pos, qualified by the
+// package name.
+func enclosingDecl(packageName string, file *ast.File, pos token.Pos) string {
+	for _, decl := range file.Decls {
+		if pos < decl.Pos() || pos >= decl.End() {
+			continue
+		}
+		switch node := decl.(type) {
+		case *ast.FuncDecl:
+			return declSymbol(packageName, node)
+		case *ast.GenDecl:
+			for _, spec := range node.Specs {
+				if pos < spec.Pos() || pos >= spec.End() {
+					continue
+				}
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					return packageName + "." + spec.Name.Name
+				case *ast.ValueSpec:
+					return packageName + "." + spec.Names[0].Name
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+package codescout
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// referenceSites returns the enclosing declaration and line of each reference.
+func referenceSites(references []*Reference) []string {
+	sites := make([]string, 0, len(references))
+	for _, reference := range references {
+		sites = append(sites, fmt.Sprintf("%s:%d", reference.Enclosing, reference.Line))
+	}
+	return sites
+}
+
+func TestReferences(t *testing.T) {
+	dir := filepath.Join("testdata", "scout_refs")
+	path := filepath.Join(dir, "...")
+	storePath := filepath.Join(dir, "store.go")
+
+	funcNode, err := ScoutFunction(storePath, FuncConfig{Name: "Open"})
+	assert.NoError(t, err)
+	references, err := References(path, funcNode, ReferencesConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"client.Fetch:7", "refs.shared:3", "refs.Reset:13"}, referenceSites(references))
+	assert.Equal(t, filepath.Join(dir, "client", "client.go"), references[0].Path)
+	assert.Equal(t, 14, references[0].Column)
+	assert.True(t, references[0].Syntactic)
+	assert.False(t, references[1].Syntactic)
+
+	methodNode, err := ScoutMethod(storePath, MethodConfig{Name: "Get"})
+	assert.NoError(t, err)
+	references, err = References(path, methodNode, ReferencesConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"client.Fetch:7", "refs.Lookup:8"}, referenceSites(references))
+
+	structNode, err := ScoutStruct(storePath, StructConfig{Name: "Store"})
+	assert.NoError(t, err)
+	references, err = References(path, structNode, ReferencesConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"refs.Open:13", "refs.Open:14", "refs.Store.Get:18", "refs.Lookup:7"}, referenceSites(references))
+
+	references, err = References(path, structNode.FieldSymbol("Values"), ReferencesConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"refs.Open:14", "refs.Store.Get:19", "refs.Reset:14"}, referenceSites(references))
+
+	references, err = References(path, structNode.FieldSymbol("Size"), ReferencesConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"refs.Open:14"}, referenceSites(references))
+
+	constNode, err := ScoutConst(storePath, ConstConfig{Name: "DefaultSize"})
+	assert.NoError(t, err)
+	references, err = References(path, constNode, ReferencesConfig{})
+	assert.NoError(t, err)
+	assert.Len(t, references, 2)
+	assert.Equal(t, 48, references[0].Column)
+	assert.Equal(t, 68, references[1].Column)
+
+	_, err = References(path, Symbol{Kind: MethodSymbol, Name: "Get", Path: storePath}, ReferencesConfig{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,120 @@
+package codescout
+
+import (
+	"context"
+	"go/ast"
+	"runtime"
+)
+
+// inspectedFile is the result of parsing and inspecting one of the files to inspect.
+type inspectedFile struct {
+	node  *ast.File
+	err   error
+	merge func()
+}
+
+// workers returns the number of files inspected concurrently, GOMAXPROCS unless Workers is set.
+func (i baseInspector) workers() int {
+	if i.Workers > 0 {
+		return i.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// scanFile parses the file at path and passes it to inspect with a copy of the base set to
+// the file, returning the parse result and the function merging what was found.
+func (i baseInspector) scanFile(path string, inspect func(base baseInspector, node *ast.File) func()) inspectedFile {
+	base := i
+	base.Path = path
+	base.done = nil
+	base.syntaxErrs = nil
+
+	var node *ast.File
+	var err error
+	if i.Types != nil {
+		node, err = i.Types.parseFile(base, path)
+	} else {
+		node, err = base.parseSource(path)
+	}
+	return inspectedFile{node: node, err: err, merge: inspect(base, node)}
+}
+
+// scan parses and inspects each file, passing it to inspect with a copy of the base set to
+// the file, and merges what was found into the inspector by calling the function inspect
+// returned, in file order, so the matches are the same whatever the number of workers. Files
+// are inspected by a bounded pool of workers running ahead of the merge, and at most that many
+// files are held inspected but not yet merged. A syntax error is handled when its file is
+// merged, so the first error in file order is returned. The scan stops with the context's
+// error once it is cancelled, and without error once the consumer of a streamed inspection
+// stopped.
+func (i *baseInspector) scan(ctx context.Context, inspect func(base baseInspector, node *ast.File) func()) error {
+	files := i.files()
+	workers := i.workers()
+	if workers < 2 || len(files) < 2 {
+		for _, path := range files {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			mergeErr := i.merge(path, i.scanFile(path, inspect))
+			if mergeErr != nil || i.stopped() {
+				return mergeErr
+			}
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]chan inspectedFile, len(files))
+	for idx := range results {
+		results[idx] = make(chan inspectedFile, 1)
+	}
+
+	// Each file holds a slot of the window until it is merged, which bounds both the number
+	// of inspecting goroutines and the number of inspected files waiting to be merged.
+	scanner := *i
+	window := make(chan struct{}, workers)
+	go func() {
+		for idx, path := range files {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(result chan<- inspectedFile, path string) {
+				result <- scanner.scanFile(path, inspect)
+			}(results[idx], path)
+		}
+	}()
+
+	for idx, path := range files {
+		var result inspectedFile
+		select {
+		case result = <-results[idx]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		mergeErr := i.merge(path, result)
+		if mergeErr != nil || i.stopped() {
+			return mergeErr
+		}
+		<-window
+	}
+	return nil
+}
+
+// merge handles the parse result of an inspected file as parsed does, and merges what was
+// found in it unless its syntax errors end the inspection.
+func (i *baseInspector) merge(path string, result inspectedFile) error {
+	_, err := i.parsed(path, result.node, result.err)
+	if err != nil {
+		return err
+	}
+	result.merge()
+	return nil
+}
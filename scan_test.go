@@ -0,0 +1,81 @@
+package codescout
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// scanFS returns a package of files each declaring a function and a struct, with a broken
+// file in the middle.
+func scanFS() fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for idx := 0; idx < 20; idx++ {
+		src := fmt.Sprintf("package pkg\n\nfunc F%02d() error { return nil }\n\ntype S%02d struct{ ID int }\n", idx, idx)
+		fsys[fmt.Sprintf("pkg/f%02d.go", idx)] = &fstest.MapFile{Data: []byte(src)}
+	}
+	fsys["pkg/f10.go"] = &fstest.MapFile{Data: []byte("package pkg\n\nfunc F10() error { return nil }\n\nfunc Broken() {\n\tif {\n\t}\n}\n")}
+	return fsys
+}
+
+func TestScanWorkers(t *testing.T) {
+	fsys := scanFS()
+	sequential, err := ScoutFunctionsFS(fsys, "pkg", FuncConfig{Workers: 1, Partial: true})
+	assert.Error(t, err)
+	assert.Len(t, sequential, 21)
+
+	for _, workers := range []int{0, 2, 8, 32} {
+		funcNodes, err := ScoutFunctionsFS(fsys, "pkg", FuncConfig{Workers: workers, Partial: true})
+		var parseErrs ParseErrors
+		assert.ErrorAs(t, err, &parseErrs)
+		assert.Len(t, parseErrs, 1)
+		assert.Equal(t, len(sequential), len(funcNodes))
+		for idx := range sequential {
+			assert.Equal(t, sequential[idx].Node, funcNodes[idx].Node)
+		}
+	}
+
+	_, err = ScoutStructsFS(fsys, "pkg", StructConfig{Workers: 4})
+	var parseErr *ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "pkg/f10.go", parseErr.File)
+}
+
+func TestScanWorkersTypes(t *testing.T) {
+	fsys := scanFS()
+	delete(fsys, "pkg/f10.go")
+
+	for _, workers := range []int{1, 8} {
+		funcNodes, err := ScoutFunctionsFS(fsys, "pkg", FuncConfig{ReturnTypes: []string{"error"}, TypeMatch: IdenticalTypes, Workers: workers})
+		assert.NoError(t, err)
+		assert.Len(t, funcNodes, 19)
+		assert.Equal(t, "F00", funcNodes[0].Name())
+		assert.Equal(t, "F19", funcNodes[18].Name())
+
+		structNodes, err := ScoutStructsFS(fsys, "pkg", StructConfig{FieldTypes: []NamedType{{Type: "int"}}, TypeMatch: IdenticalTypes, Workers: workers})
+		assert.NoError(t, err)
+		assert.Len(t, structNodes, 19)
+	}
+}
+
+func TestScanWithContext(t *testing.T) {
+	fsys := scanFS()
+	delete(fsys, "pkg/f10.go")
+
+	structNodes, err := ScoutStructsFSWithContext(context.Background(), fsys, "pkg", StructConfig{Workers: 4})
+	assert.NoError(t, err)
+	assert.Len(t, structNodes, 19)
+	assert.Equal(t, "S00", structNodes[0].Name())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, workers := range []int{1, 4} {
+		_, err = ScoutFunctionsFSWithContext(ctx, fsys, "pkg", FuncConfig{Workers: workers})
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	_, err = QueryWithContext(ctx, "testdata/scout_single.go", "func(name = Greet)")
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,91 @@
+package codescout
+
+import (
+	"io/fs"
+	pathpkg "path"
+	"path/filepath"
+
+	"github.com/galactixx/codescout/internal/pkgutils"
+)
+
+// source resolves the Go files to scout and provides their contents to the parser.
+type source interface {
+	// files returns the paths of every Go file belonging to the source.
+	files() ([]string, error)
+	// read returns the contents of the file at path, or nil to let the parser read it from disk.
+	read(path string) (any, error)
+	// packageFiles returns the Go files in the directory of the file at path.
+	packageFiles(path string) ([]string, error)
+}
+
+// sourceFor returns src, or a disk source rooted at path when no source was given.
+func sourceFor(path string, src source) source {
+	if src == nil {
+		return diskSource{Path: path}
+	}
+	return src
+}
+
+// diskSource reads Go files from a file, directory, "./..." pattern or package on disk.
+type diskSource struct {
+	Path string
+}
+
+// files resolves the path into the Go files it refers to.
+func (s diskSource) files() ([]string, error) { return pkgutils.ResolveGoFiles(s.Path) }
+
+// read defers reading the file to the parser.
+func (s diskSource) read(path string) (any, error) { return nil, nil }
+
+// packageFiles resolves the Go files in the directory of the file.
+func (s diskSource) packageFiles(path string) ([]string, error) {
+	return pkgutils.ResolveGoFiles(filepath.Dir(path))
+}
+
+// memorySource holds a single Go file in memory under the given name.
+type memorySource struct {
+	Name string
+	Src  any
+}
+
+// files returns the name of the in-memory file.
+func (s memorySource) files() ([]string, error) { return []string{s.Name}, nil }
+
+// read returns the in-memory contents of the file.
+func (s memorySource) read(path string) (any, error) { return s.Src, nil }
+
+// packageFiles returns the name of the in-memory file, the only file in its package.
+func (s memorySource) packageFiles(path string) ([]string, error) { return []string{s.Name}, nil }
+
+// fsSource reads Go files from a file, directory or "dir/..." pattern within an fs.FS.
+type fsSource struct {
+	FS   fs.FS
+	Path string
+}
+
+// files resolves the path into the Go files it refers to within the file system.
+func (s fsSource) files() ([]string, error) { return pkgutils.ResolveGoFilesFS(s.FS, s.Path) }
+
+// read returns the contents of the file from the file system.
+func (s fsSource) read(path string) (any, error) { return fs.ReadFile(s.FS, path) }
+
+// packageFiles resolves the Go files in the directory of the file within the file system.
+func (s fsSource) packageFiles(path string) ([]string, error) {
+	return pkgutils.ResolveGoFilesFS(s.FS, pathpkg.Dir(path))
+}
+
+// fileListSource reads a fixed list of Go files from disk.
+type fileListSource struct {
+	Files []string
+}
+
+// files returns the listed files.
+func (s fileListSource) files() ([]string, error) { return s.Files, nil }
+
+// read defers reading the file to the parser.
+func (s fileListSource) read(path string) (any, error) { return nil, nil }
+
+// packageFiles resolves the Go files in the directory of the file.
+func (s fileListSource) packageFiles(path string) ([]string, error) {
+	return pkgutils.ResolveGoFiles(filepath.Dir(path))
+}
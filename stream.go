@@ -0,0 +1,95 @@
+package codescout
+
+import "fmt"
+
+// Seq2 is a sequence of pairs handed out one at a time to yield, which stops the sequence by
+// returning false. It has the shape of iter.Seq2, so from Go 1.23 it can be ranged over:
+//
+//	for fn, err := range codescout.StreamFunctions("./...", codescout.FuncConfig{}) {
+//		...
+//	}
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// streamer is implemented by the inspectors whose matches are final as soon as they are
+// found, so they can be handed out while the rest of the files are still being scouted.
+type streamer[T any] interface {
+	// stream arranges for each match to be passed to yield as soon as it is found, and for the
+	// inspection to stop once yield returns false. An error is returned, leaving the inspector
+	// unchanged, if the matches could not be handed out in their configured order.
+	stream(yield func(node *T) bool) error
+}
+
+// streamNodes returns a done function for baseInspector, which passes the nodes matched since
+// its last call to yield and drops them, so a streamed inspection holds no matches.
+func streamNodes[T any](nodes *[]*T, yield func(node *T) bool) func() bool {
+	stopped := false
+	return func() bool {
+		for _, node := range *nodes {
+			if stopped {
+				break
+			}
+			stopped = !yield(node)
+		}
+		*nodes = (*nodes)[:0]
+		return stopped
+	}
+}
+
+// validateStreamOrder returns an error unless matches are ordered by position, which is the
+// order they are found in.
+func validateStreamOrder(order SortOrder) error {
+	if order != "" && order != PositionSort {
+		return fmt.Errorf("Sort %s is not supported when streaming, matches are streamed in position order", order)
+	}
+	return nil
+}
+
+// stream hands out the matched functions as they are found.
+func (i *funcInspector) stream(yield func(node *FuncNode) bool) error {
+	orderErr := validateStreamOrder(i.Config.Sort)
+	if orderErr != nil {
+		return orderErr
+	}
+	i.Base.done = streamNodes(&i.Nodes, yield)
+	return nil
+}
+
+// stream hands out the matched methods as they are found.
+func (i *methodInspector) stream(yield func(node *MethodNode) bool) error {
+	orderErr := validateStreamOrder(i.Config.Sort)
+	if orderErr != nil {
+		return orderErr
+	}
+	i.Base.done = streamNodes(&i.Nodes, yield)
+	return nil
+}
+
+// stream hands out the matched interfaces as they are found.
+func (i *interfaceInspector) stream(yield func(node *InterfaceNode) bool) error {
+	orderErr := validateStreamOrder(i.Config.Sort)
+	if orderErr != nil {
+		return orderErr
+	}
+	i.Base.done = streamNodes(&i.Nodes, yield)
+	return nil
+}
+
+// stream hands out the matched constants as they are found.
+func (i *constInspector) stream(yield func(node *ConstNode) bool) error {
+	orderErr := validateStreamOrder(i.Config.Sort)
+	if orderErr != nil {
+		return orderErr
+	}
+	i.Base.done = streamNodes(&i.Nodes, yield)
+	return nil
+}
+
+// stream hands out the matched variables as they are found.
+func (i *varInspector) stream(yield func(node *VarNode) bool) error {
+	orderErr := validateStreamOrder(i.Config.Sort)
+	if orderErr != nil {
+		return orderErr
+	}
+	i.Base.done = streamNodes(&i.Nodes, yield)
+	return nil
+}
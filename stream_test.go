@@ -0,0 +1,101 @@
+package codescout
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// streamDir writes the files of scanFS into a temporary directory and returns it.
+func streamDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, file := range scanFS() {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, filepath.Base(name)), file.Data, 0o644))
+	}
+	return dir
+}
+
+func TestStreamFunctions(t *testing.T) {
+	dir := streamDir(t)
+	config := FuncConfig{NamePattern: "^F", PatternMode: RegexPattern, Partial: true}
+	collected, err := ScoutFunctions(dir, config)
+	assert.Error(t, err)
+
+	for _, workers := range []int{1, 8} {
+		config.Workers = workers
+		streamed := make([]*FuncNode, 0)
+		var streamErr error
+		StreamFunctions(dir, config)(func(node *FuncNode, err error) bool {
+			if err != nil {
+				streamErr = err
+				return true
+			}
+			streamed = append(streamed, node)
+			return true
+		})
+		assert.Equal(t, len(collected), len(streamed), fmt.Sprintf("workers: %d", workers))
+		for idx := range collected {
+			assert.Equal(t, collected[idx].Node, streamed[idx].Node)
+		}
+		assert.IsType(t, ParseErrors{}, streamErr)
+	}
+
+	// Stopping the sequence stops scouting, so the broken file is never reached.
+	names := make([]string, 0)
+	StreamFunctions(dir, config)(func(node *FuncNode, err error) bool {
+		require.NoError(t, err)
+		names = append(names, node.Name())
+		return len(names) < 3
+	})
+	assert.Equal(t, []string{"F00", "F01", "F02"}, names)
+}
+
+func TestStreamErrors(t *testing.T) {
+	dir := streamDir(t)
+	var errs []error
+	StreamMethods(dir, MethodConfig{Sort: NameSort})(func(node *MethodNode, err error) bool {
+		errs = append(errs, err)
+		return true
+	})
+	assert.Equal(t, 1, len(errs))
+	assert.EqualError(t, errs[0], "Sort name is not supported when streaming, matches are streamed in position order")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs = nil
+	StreamVarsWithContext(ctx, dir, VarConfig{})(func(node *VarNode, err error) bool {
+		errs = append(errs, err)
+		return true
+	})
+	assert.Equal(t, []error{context.Canceled}, errs)
+
+	// A syntax error ends the sequence unless the config enables Partial.
+	count := 0
+	StreamFunctions(dir, FuncConfig{Workers: 1})(func(node *FuncNode, err error) bool {
+		if err != nil {
+			var parseErr *ParseError
+			assert.ErrorAs(t, err, &parseErr)
+			return true
+		}
+		count++
+		return true
+	})
+	assert.Equal(t, 10, count)
+}
+
+func TestScoutFunctionStopsAtFirstMatch(t *testing.T) {
+	dir := streamDir(t)
+	node, err := ScoutFunction(dir, FuncConfig{Name: "F03"})
+	require.NoError(t, err)
+	assert.Equal(t, "F03", node.Name())
+
+	node, err = ScoutFunction(dir, FuncConfig{NamePattern: "^F", PatternMode: RegexPattern, Sort: NameSort, Partial: true})
+	assert.Error(t, err)
+	assert.Equal(t, "F00", node.Name())
+}
@@ -0,0 +1,35 @@
+package calls
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Greeter produces greetings.
+type Greeter interface {
+	Greet(name string) string
+}
+
+// Formal greets with a title.
+type Formal struct {
+	Title string
+}
+
+// Greet returns a formal greeting.
+func (f *Formal) Greet(name string) string {
+	return fmt.Sprintf("%s %s", f.Title, f.normalize(name))
+}
+
+// normalize upper-cases the name.
+func (f *Formal) normalize(name string) string {
+	return strings.ToUpper(name)
+}
+
+// Welcome greets every name with the greeter.
+func Welcome(greeter Greeter, names []string) []string {
+	greetings := make([]string, 0, len(names))
+	for _, name := range names {
+		greetings = append(greetings, greeter.Greet(name))
+	}
+	return greetings
+}
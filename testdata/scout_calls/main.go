@@ -0,0 +1,17 @@
+package calls
+
+// Map applies fn to every value.
+func Map[T any](values []T, fn func(T) T) []T {
+	mapped := make([]T, 0, len(values))
+	for _, value := range values {
+		mapped = append(mapped, fn(value))
+	}
+	return mapped
+}
+
+// Run welcomes the names formally.
+func Run(names []string) []string {
+	formal := &Formal{Title: "Dr."}
+	trimmed := Map(names, func(name string) string { return formal.normalize(name) })
+	return Welcome(formal, trimmed)
+}
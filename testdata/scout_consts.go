@@ -0,0 +1,42 @@
+package calendar
+
+import "time"
+
+// Weekday is a day of the week.
+type Weekday int
+
+// Days of the week.
+const (
+	Sunday Weekday = iota // first day
+	Monday
+	Tuesday
+)
+
+// Sizes in bytes.
+const (
+	_  = iota
+	KB = 1 << (10 * iota)
+	MB
+)
+
+// MaxRetries is the retry limit.
+const MaxRetries = 3
+
+const (
+	defaultName = "calendar"
+	timeout     = 5 * time.Second
+)
+
+// DefaultZone is the default time zone.
+var DefaultZone = "UTC"
+
+var (
+	cache   map[string]Weekday
+	counter int = 1
+)
+
+func today() Weekday {
+	const local = 2
+	var day Weekday = local
+	return day
+}
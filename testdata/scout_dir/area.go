@@ -0,0 +1,16 @@
+package shapes
+
+import "fmt"
+
+// Area returns the area of the rectangle.
+func (r *Rect) Area() float64 {
+	return r.Width * r.Height
+}
+
+// Describe returns a printable description of the rectangle.
+func Describe(r *Rect) (string, error) {
+	if r == nil {
+		return "", fmt.Errorf("nil rectangle")
+	}
+	return fmt.Sprintf("%vx%v", r.Width, r.Height), nil
+}
@@ -0,0 +1,16 @@
+package nested
+
+import "errors"
+
+// Circle is a circle with a radius.
+type Circle struct {
+	Radius float64
+}
+
+// Validate reports whether the circle has a positive radius.
+func (c Circle) Validate() error {
+	if c.Radius <= 0 {
+		return errors.New("radius must be positive")
+	}
+	return nil
+}
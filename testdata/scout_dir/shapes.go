@@ -0,0 +1,17 @@
+package shapes
+
+import "errors"
+
+// Rect is a rectangle with a width and height.
+type Rect struct {
+	Width  float64
+	Height float64
+}
+
+// NewRect validates the dimensions and returns a new Rect.
+func NewRect(width, height float64) (*Rect, error) {
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("dimensions must be positive")
+	}
+	return &Rect{Width: width, Height: height}, nil
+}
@@ -0,0 +1,37 @@
+package embeds
+
+import "sync"
+
+// Entity holds the identity shared by stored records.
+type Entity struct {
+	ID      int
+	Version int
+}
+
+// Touch bumps the entity version.
+func (e *Entity) Touch() { e.Version++ }
+
+// Audit records who changed a record.
+type Audit struct {
+	Entity
+	By string
+}
+
+// Owner identifies who a record belongs to.
+type Owner struct {
+	By string
+}
+
+// Document is a stored record with an audit trail.
+type Document struct {
+	*Audit
+	Owner
+	sync.Mutex
+	Title string
+	ID    string
+}
+
+// Plain embeds nothing.
+type Plain struct {
+	Name string
+}
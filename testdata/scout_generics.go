@@ -0,0 +1,61 @@
+package generics
+
+// Stack is a last-in first-out collection.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds an item to the top of the stack.
+func (s *Stack[T]) Push(item T) {
+	s.items = append(s.items, item)
+}
+
+// Len returns the number of items in the stack.
+func (s Stack[E]) Len() int { return len(s.items) }
+
+// Cache maps comparable keys to values.
+type Cache[K comparable, V any] struct {
+	entries map[K]V
+}
+
+// Get returns the value stored under the key.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	value, ok := c.entries[key]
+	return value, ok
+}
+
+// Counter counts occurrences.
+type Counter struct {
+	counts map[string]int
+}
+
+// Incr increments the count of the key.
+func (c *Counter) Incr(key string) { c.counts[key]++ }
+
+// Index returns the position of the target in the values.
+func Index[T comparable](values []T, target T) int {
+	for idx, value := range values {
+		if value == target {
+			return idx
+		}
+	}
+	return -1
+}
+
+// Map applies the function to every value.
+func Map[S ~[]E, E, R any](values S, fn func(E) R) []R {
+	mapped := make([]R, 0, len(values))
+	for _, value := range values {
+		mapped = append(mapped, fn(value))
+	}
+	return mapped
+}
+
+// Sum adds up the values.
+func Sum(values []int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
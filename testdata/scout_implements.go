@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+)
+
+// Store persists values by key.
+type Store interface {
+	Get(key string) ([]byte, error)
+	io.Closer
+}
+
+// MemStore keeps values in memory.
+type MemStore struct {
+	values map[string][]byte
+}
+
+func (m *MemStore) Get(key string) ([]byte, error) { return m.values[key], nil }
+
+func (m *MemStore) Close() error { return nil }
+
+// FileStore keeps values on disk.
+type FileStore struct {
+	dir string
+}
+
+func (f FileStore) Get(name string) ([]byte, error) { return nil, nil }
+
+func (f FileStore) Close() error { return nil }
+
+// IntStore has the wrong key type.
+type IntStore struct{}
+
+func (s IntStore) Get(key int) ([]byte, error) { return nil, nil }
+
+func (s IntStore) Close() error { return nil }
+
+// Buffer is a readable byte slice.
+type Buffer []byte
+
+func (b Buffer) Read(p []byte) (n int, err error) { return copy(p, b), nil }
+
+// CachedStore embeds a MemStore, whose pointer methods are promoted to *CachedStore only.
+type CachedStore struct {
+	MemStore
+}
+
+// SharedStore embeds a *MemStore, whose methods are promoted to SharedStore.
+type SharedStore struct {
+	*MemStore
+}
+
+// LogBuffer embeds a *bytes.Buffer and so is an io.Writer.
+type LogBuffer struct {
+	*bytes.Buffer
+}
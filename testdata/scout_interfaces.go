@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// Reader reads records from storage.
+type Reader interface {
+	Read(ctx context.Context, key string) ([]byte, error)
+}
+
+// ReadCloser reads records and can be closed.
+type ReadCloser interface {
+	Reader
+	io.Closer
+	Keys() []string
+}
+
+// Number constrains numeric types.
+type Number interface {
+	~int | ~int64 | float64
+}
+
+type Empty interface{}
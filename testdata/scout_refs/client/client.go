@@ -0,0 +1,8 @@
+package client
+
+import "github.com/galactixx/codescout/testdata/scout_refs/missing/refs"
+
+// Fetch reads a key from a new store.
+func Fetch(key string) string {
+	return refs.Open().Get(key)
+}
@@ -0,0 +1,20 @@
+package refs
+
+// DefaultSize is the size of a new store.
+const DefaultSize = 8
+
+// Store holds values by key.
+type Store struct {
+	Values map[string]string
+	Size   int
+}
+
+// Open returns an empty store.
+func Open() *Store {
+	return &Store{Values: make(map[string]string, DefaultSize), Size: DefaultSize}
+}
+
+// Get returns the value stored under key.
+func (s *Store) Get(key string) string {
+	return s.Values[key]
+}
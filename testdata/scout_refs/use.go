@@ -0,0 +1,15 @@
+package refs
+
+var shared = Open()
+
+// Lookup reads a key from the shared store.
+func Lookup(key string) string {
+	var store *Store = shared
+	return store.Get(key)
+}
+
+// Reset replaces the shared store.
+func Reset() {
+	shared = Open()
+	shared.Values = nil
+}
@@ -0,0 +1,19 @@
+package api
+
+// User is returned by the users endpoint.
+type User struct {
+	ID       int    `json:"id" db:"id"`
+	Email    string `json:"email" db:"email" validate:"required,email"`
+	Password string `json:"-" db:"password_hash"`
+}
+
+// Session is kept in memory only.
+type Session struct {
+	Token  string `json:"token"`
+	UserID int    `json:"user_id"`
+}
+
+// Options has no tags.
+type Options struct {
+	Verbose bool
+}
@@ -0,0 +1,4 @@
+package typed
+
+// Payload is the raw bytes being encoded.
+type Payload = []byte
@@ -0,0 +1,38 @@
+package typed
+
+import (
+	"bytes"
+	stdctx "context"
+	"io"
+)
+
+// Buffer wraps a byte slice.
+type Buffer struct {
+	Data   []uint8
+	Reader io.Reader
+}
+
+// Decode reads the payload from the reader.
+func Decode(ctx stdctx.Context, r io.Reader) ([]uint8, error) {
+	return nil, nil
+}
+
+// Encode writes the payload to a new buffer.
+func Encode(payload Payload) *bytes.Buffer {
+	return bytes.NewBuffer(payload)
+}
+
+// Sum adds up the values.
+func Sum(values ...int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
+
+// Write appends the payload to the buffer.
+func (b *Buffer) Write(p Payload) (int, error) {
+	b.Data = append(b.Data, p...)
+	return len(p), nil
+}
@@ -0,0 +1,40 @@
+package units
+
+import "net/http"
+
+// Celsius is a temperature.
+type Celsius float64
+
+func (c Celsius) String() string { return "" }
+
+// HandlerFunc adapts a function to a handler.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request)
+
+func (f HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) { f(w, r) }
+
+type (
+	// IDs is a list of identifiers.
+	IDs []string
+	// Lookup maps names to identifiers.
+	Lookup map[string]IDs
+	// Events streams event names.
+	Events chan string
+	// Ref points at a temperature.
+	Ref *Celsius
+	// Grid is a fixed size grid.
+	Grid [3][3]int
+)
+
+// Temp is an alias of Celsius.
+type Temp = Celsius
+
+// Status reuses the HTTP handler type.
+type Status http.Handler
+
+type point struct{ x, y int }
+
+func origin() point {
+	type local struct{ x, y int }
+	type offset int
+	return point(local{})
+}
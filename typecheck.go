@@ -0,0 +1,458 @@
+package codescout
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// TypeMatchMode selects how configured parameter, return and field types are compared
+// with the types declared in the scouted code.
+type TypeMatchMode string
+
+const (
+	// TextualTypes compares the printed source of each type, the default.
+	TextualTypes TypeMatchMode = "text"
+	// IdenticalTypes type-checks the package and matches types by identity, so "[]byte"
+	// matches "[]uint8" and "context.Context" matches a renamed import of it.
+	IdenticalTypes TypeMatchMode = "identical"
+	// AssignableTypes type-checks the package and matches declared types that are assignable
+	// to the configured type, so "io.Reader" matches a "*bytes.Buffer" parameter.
+	AssignableTypes TypeMatchMode = "assignable"
+)
+
+// validateTypeMatch returns an error if the type match mode is unknown.
+func validateTypeMatch(mode TypeMatchMode) error {
+	if mode != "" && mode != TextualTypes && mode != IdenticalTypes && mode != AssignableTypes {
+		return fmt.Errorf("TypeMatch must be one of: %s, %s, %s", TextualTypes, IdenticalTypes, AssignableTypes)
+	}
+	return nil
+}
+
+// newTypeChecker returns a type checker for the mode, or nil when types are compared textually.
+func newTypeChecker(mode TypeMatchMode, fset *token.FileSet) *typeChecker {
+	if mode == "" || mode == TextualTypes {
+		return nil
+	}
+	return &typeChecker{
+		Mode:     mode,
+		Fset:     fset,
+		importer: importer.ForCompiler(fset, "source", nil),
+		files:    make(map[string]*checkedFile),
+	}
+}
+
+// checkedPackage holds the result of type-checking a package.
+type checkedPackage struct {
+	pkg  *types.Package
+	info *types.Info
+}
+
+// checkedFile holds a parsed file together with the package it was type-checked in.
+type checkedFile struct {
+	node *ast.File
+	err  error
+	pkg  *checkedPackage
+}
+
+// typeChecker parses and type-checks the package of each scouted file, so that configured
+// types can be matched against declared types by identity or assignability. Type errors,
+// such as imports that cannot be resolved offline, are tolerated and leave the affected
+// types invalid, which never match. It is shared by the files inspected concurrently, so mu
+// guards the checked files and the importer.
+type typeChecker struct {
+	Mode TypeMatchMode
+	Fset *token.FileSet
+
+	mu       sync.Mutex
+	importer types.Importer
+	files    map[string]*checkedFile
+}
+
+// parseFile returns the file at path as parsed for type-checking, checking its package first
+// if it has not been seen yet.
+func (c *typeChecker) parseFile(base baseInspector, path string) (*ast.File, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if checked, ok := c.files[path]; ok {
+		return checked.node, checked.err
+	}
+
+	packagePaths, err := base.Source.packageFiles(path)
+	if err != nil || !containsPath(packagePaths, path) {
+		packagePaths = append(packagePaths, path)
+	}
+	for _, file := range base.files() {
+		if filepath.Dir(file) == filepath.Dir(path) && !containsPath(packagePaths, file) {
+			packagePaths = append(packagePaths, file)
+		}
+	}
+
+	packages := make(map[string][]*ast.File)
+	for _, packagePath := range packagePaths {
+		if _, seen := c.files[packagePath]; seen {
+			continue
+		}
+		node, parseErr := base.parseSource(packagePath)
+		c.files[packagePath] = &checkedFile{node: node, err: parseErr}
+		if node != nil {
+			packages[node.Name.Name] = append(packages[node.Name.Name], node)
+		}
+	}
+
+	for name, nodes := range packages {
+		checked := c.check(name, nodes)
+		for _, packagePath := range packagePaths {
+			if file := c.files[packagePath]; file.pkg == nil && file.node != nil && file.node.Name.Name == name {
+				file.pkg = checked
+			}
+		}
+	}
+	checked := c.files[path]
+	return checked.node, checked.err
+}
+
+// containsPath returns true if the path is in the slice of paths.
+func containsPath(paths []string, path string) bool {
+	for _, candidate := range paths {
+		if filepath.Clean(candidate) == filepath.Clean(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// check type-checks the files of a single package, ignoring type errors.
+func (c *typeChecker) check(name string, nodes []*ast.File) *checkedPackage {
+	info := &types.Info{
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+	}
+	conf := types.Config{Importer: c.importer, Error: func(error) {}}
+	dir := filepath.Dir(c.Fset.Position(nodes[0].Package).Filename)
+	pkg, _ := conf.Check(packagePath(dir, name), c.Fset, nodes, info)
+	return &checkedPackage{pkg: pkg, info: info}
+}
+
+// packagePath returns the import path of the package in dir, derived from the module it
+// belongs to, e.g. "example.com/app/storage". The current directory "." is resolved like any
+// other, so the root package of a module scanned from its root has the same path as when it
+// is imported. Outside a module the directory itself is the path, and a package without a
+// directory is known by its name.
+func packagePath(dir string, name string) string {
+	if dir == "" {
+		return name
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return filepath.ToSlash(dir)
+	}
+	for moduleDir := absDir; ; moduleDir = filepath.Dir(moduleDir) {
+		if data, readErr := os.ReadFile(filepath.Join(moduleDir, "go.mod")); readErr == nil {
+			modulePath := modfileModulePath(data)
+			rel, relErr := filepath.Rel(moduleDir, absDir)
+			if modulePath == "" || relErr != nil {
+				break
+			}
+			if rel == "." {
+				return modulePath
+			}
+			return modulePath + "/" + filepath.ToSlash(rel)
+		}
+		if filepath.Dir(moduleDir) == moduleDir {
+			break
+		}
+	}
+	return filepath.ToSlash(dir)
+}
+
+// modfileModulePath returns the module path declared by the contents of a go.mod file.
+func modfileModulePath(data []byte) string {
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\"`")
+		}
+	}
+	return ""
+}
+
+// packageOf returns the checked package of the file at path.
+func (c *typeChecker) packageOf(path string) *checkedPackage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if checked, ok := c.files[path]; ok && checked.pkg != nil && checked.pkg.pkg != nil {
+		return checked.pkg
+	}
+	return nil
+}
+
+// typedName is a declared parameter, result or field name with its checked type.
+type typedName struct {
+	Name string
+	Type types.Type
+}
+
+// signatureOf returns the checked signature of a function or method declaration.
+func (c *typeChecker) signatureOf(path string, decl *ast.FuncDecl) *types.Signature {
+	checked := c.packageOf(path)
+	if checked == nil {
+		return nil
+	}
+	if fn, ok := checked.info.Defs[decl.Name].(*types.Func); ok {
+		signature, _ := fn.Type().(*types.Signature)
+		return signature
+	}
+	return nil
+}
+
+// tupleNames converts a parameter or result tuple into typed names.
+func tupleNames(tuple *types.Tuple) []typedName {
+	names := make([]typedName, 0, tuple.Len())
+	for idx := 0; idx < tuple.Len(); idx++ {
+		names = append(names, typedName{Name: tuple.At(idx).Name(), Type: tuple.At(idx).Type()})
+	}
+	return names
+}
+
+// paramsOf returns the checked parameters of a function or method declaration.
+func (c *typeChecker) paramsOf(path string, decl *ast.FuncDecl) []typedName {
+	signature := c.signatureOf(path, decl)
+	if signature == nil {
+		return nil
+	}
+	return tupleNames(signature.Params())
+}
+
+// resultsOf returns the checked results of a function or method declaration.
+func (c *typeChecker) resultsOf(path string, decl *ast.FuncDecl) []typedName {
+	signature := c.signatureOf(path, decl)
+	if signature == nil {
+		return nil
+	}
+	return tupleNames(signature.Results())
+}
+
+// fieldsOf returns the checked fields of a struct declaration, including embedded fields.
+func (c *typeChecker) fieldsOf(path string, spec *ast.TypeSpec) []typedName {
+	checked := c.packageOf(path)
+	if checked == nil {
+		return nil
+	}
+	typeName, ok := checked.info.Defs[spec.Name].(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	structType, ok := typeName.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	fields := make([]typedName, 0, structType.NumFields())
+	for idx := 0; idx < structType.NumFields(); idx++ {
+		field := structType.Field(idx)
+		fields = append(fields, typedName{Name: field.Name(), Type: field.Type()})
+	}
+	return fields
+}
+
+// typeMatches returns true if the declared type matches the configured type under the mode.
+func (c *typeChecker) typeMatches(declared types.Type, configured types.Type) bool {
+	if declared == nil || configured == nil || !isValidType(declared) || !isValidType(configured) {
+		return false
+	}
+	if c.Mode == AssignableTypes {
+		return types.AssignableTo(declared, configured)
+	}
+	return types.Identical(declared, configured)
+}
+
+// isValidType returns true if the type was resolved by the type checker.
+func isValidType(t types.Type) bool {
+	basic, isBasic := t.(*types.Basic)
+	return !isBasic || basic.Kind() != types.Invalid
+}
+
+// namedTypesValidator returns a validator matching configured named types against the typed
+// names, each typed name matching at most one configured entry. When ordered, each configured
+// entry only matches the typed name at its position.
+func (c *typeChecker) namedTypesValidator(path string, declared []typedName, ordered bool) func([]NamedType, []NamedType) bool {
+	return func(configTypes []NamedType, _ []NamedType) bool {
+		checked := c.packageOf(path)
+		if checked == nil {
+			return false
+		}
+		used := make([]bool, len(declared))
+		for configIdx, configType := range configTypes {
+			var resolved types.Type
+			if configType.Type != "" {
+				resolved = c.resolve(checked, configType.Type)
+				if resolved == nil {
+					return false
+				}
+			}
+			found := false
+			for idx, typed := range declared {
+				if used[idx] || (ordered && idx != configIdx) || (configType.Name != "" && configType.Name != typed.Name) {
+					continue
+				}
+				if resolved == nil || c.typeMatches(typed.Type, resolved) {
+					used[idx] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// typesValidator returns a validator matching configured types against the typed names,
+// each typed name matching at most one configured type.
+func (c *typeChecker) typesValidator(path string, declared []typedName) func([]string, []string) bool {
+	namedValidator := c.namedTypesValidator(path, declared, false)
+	return func(configTypes []string, _ []string) bool {
+		namedTypes := make([]NamedType, 0, len(configTypes))
+		for _, configType := range configTypes {
+			namedTypes = append(namedTypes, NamedType{Type: configType})
+		}
+		return namedValidator(namedTypes, nil)
+	}
+}
+
+// resolve resolves a configured type expression, such as "[]byte" or "context.Context", within
+// the scope of the checked package. Package qualifiers are matched against the package's imports
+// by name or path, so renamed imports resolve, and are otherwise imported by path. The "builtin"
+// qualifier refers to predeclared types. It returns nil if the type cannot be resolved.
+func (c *typeChecker) resolve(checked *checkedPackage, typeString string) types.Type {
+	typeString = strings.TrimSpace(typeString)
+	if variadic, ok := strings.CutPrefix(typeString, "..."); ok {
+		elem := c.resolve(checked, variadic)
+		if elem == nil {
+			return nil
+		}
+		return types.NewSlice(elem)
+	}
+	expr, err := parser.ParseExpr(typeString)
+	if err != nil {
+		return nil
+	}
+	return c.resolveExpr(checked, expr)
+}
+
+// resolveExpr resolves a type expression within the scope of the checked package.
+func (c *typeChecker) resolveExpr(checked *checkedPackage, expr ast.Expr) types.Type {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		_, object := checked.pkg.Scope().LookupParent(expr.Name, token.NoPos)
+		return typeObject(object)
+	case *ast.SelectorExpr:
+		qualifier, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		if qualifier.Name == "builtin" {
+			return typeObject(types.Universe.Lookup(expr.Sel.Name))
+		}
+		imported := c.importedPackage(checked, qualifier.Name)
+		if imported == nil {
+			return nil
+		}
+		return typeObject(imported.Scope().Lookup(expr.Sel.Name))
+	case *ast.ParenExpr:
+		return c.resolveExpr(checked, expr.X)
+	case *ast.StarExpr:
+		elem := c.resolveExpr(checked, expr.X)
+		if elem == nil {
+			return nil
+		}
+		return types.NewPointer(elem)
+	case *ast.ArrayType:
+		elem := c.resolveExpr(checked, expr.Elt)
+		if elem == nil {
+			return nil
+		}
+		if expr.Len == nil {
+			return types.NewSlice(elem)
+		}
+		length, ok := constant.Int64Val(c.constValue(checked, expr.Len))
+		if !ok {
+			return nil
+		}
+		return types.NewArray(elem, length)
+	case *ast.MapType:
+		key, value := c.resolveExpr(checked, expr.Key), c.resolveExpr(checked, expr.Value)
+		if key == nil || value == nil {
+			return nil
+		}
+		return types.NewMap(key, value)
+	case *ast.ChanType:
+		elem := c.resolveExpr(checked, expr.Value)
+		if elem == nil {
+			return nil
+		}
+		dir := types.SendRecv
+		if expr.Dir == ast.SEND {
+			dir = types.SendOnly
+		} else if expr.Dir == ast.RECV {
+			dir = types.RecvOnly
+		}
+		return types.NewChan(dir, elem)
+	default:
+		c.mu.Lock()
+		evaluated, err := types.Eval(c.Fset, checked.pkg, token.NoPos, types.ExprString(expr))
+		c.mu.Unlock()
+		if err != nil || !evaluated.IsType() {
+			return nil
+		}
+		return evaluated.Type
+	}
+}
+
+// constValue evaluates a constant expression, such as an array length, in the checked package.
+func (c *typeChecker) constValue(checked *checkedPackage, expr ast.Expr) constant.Value {
+	c.mu.Lock()
+	evaluated, err := types.Eval(c.Fset, checked.pkg, token.NoPos, types.ExprString(expr))
+	c.mu.Unlock()
+	if err != nil || evaluated.Value == nil {
+		return constant.MakeUnknown()
+	}
+	return evaluated.Value
+}
+
+// typeObject returns the type named by the object, or nil if the object is not a type.
+func typeObject(object types.Object) types.Type {
+	if typeName, ok := object.(*types.TypeName); ok {
+		return typeName.Type()
+	}
+	return nil
+}
+
+// importedPackage returns the package a configured qualifier refers to, looking it up among
+// the checked package's imports by name or path before importing it by path.
+func (c *typeChecker) importedPackage(checked *checkedPackage, qualifier string) *types.Package {
+	for _, imported := range checked.pkg.Imports() {
+		if imported.Name() == qualifier || imported.Path() == qualifier {
+			return imported
+		}
+	}
+	c.mu.Lock()
+	imported, err := c.importer.Import(qualifier)
+	c.mu.Unlock()
+	if err != nil {
+		return nil
+	}
+	return imported
+}